@@ -0,0 +1,209 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// GRPCServiceName is the full name of the admin gRPC service defined in proto/admin.proto.
+	GRPCServiceName = "goxkit.metrics.admin.v1.MetricsAdmin"
+
+	// protoFile is the path of the service definition in the protobuf registry.
+	protoFile = "goxkit/metrics/admin/v1/admin.proto"
+)
+
+type (
+	// GRPCAuthFunc authorizes an admin call, returning false rejects it with PermissionDenied.
+	GRPCAuthFunc func(ctx context.Context) bool
+
+	// grpcService serves the admin gRPC API of a Registry.
+	grpcService struct {
+		registry *Registry
+		auth     GRPCAuthFunc
+	}
+)
+
+// registerDescriptorOnce registers the service descriptor once and remembers the
+// error, so every later call reports a failed registration.
+var registerDescriptorOnce = sync.OnceValue(registerDescriptor)
+
+// RegisterGRPCService registers the admin gRPC service of the registry in the server.
+// The service descriptor is also registered in the global protobuf registry, so the
+// service is listed by the gRPC reflection service when it is enabled by the server.
+// Every call must be authorized by auth, a nil auth rejects all calls.
+//
+// Parameters:
+//   - s: The gRPC server
+//   - registry: The registry holding the runtime controls, shared with the HTTP handler
+//   - auth: The authorization hook
+//
+// Returns:
+//   - An error if the service descriptor cannot be registered
+func RegisterGRPCService(s grpc.ServiceRegistrar, registry *Registry, auth GRPCAuthFunc) error {
+	if err := registerDescriptorOnce(); err != nil {
+		return err
+	}
+
+	s.RegisterService(&grpcServiceDesc, &grpcService{registry: registry, auth: auth})
+	return nil
+}
+
+// grpcServiceDesc describes the admin service, it matches proto/admin.proto.
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: GRPCServiceName,
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("ListCollectors", func() proto.Message { return &emptypb.Empty{} }, (*grpcService).listCollectors),
+		unaryMethod("EnableCollector", func() proto.Message { return &wrapperspb.StringValue{} }, (*grpcService).enableCollector),
+		unaryMethod("DisableCollector", func() proto.Message { return &wrapperspb.StringValue{} }, (*grpcService).disableCollector),
+		unaryMethod("GetInterval", func() proto.Message { return &emptypb.Empty{} }, (*grpcService).getInterval),
+		unaryMethod("SetInterval", func() proto.Message { return &durationpb.Duration{} }, (*grpcService).setInterval),
+		unaryMethod("Flush", func() proto.Message { return &emptypb.Empty{} }, (*grpcService).flush),
+	},
+	Metadata: protoFile,
+}
+
+func (s *grpcService) listCollectors(_ context.Context, _ proto.Message) (proto.Message, error) {
+	fields := map[string]any{}
+	for _, c := range s.registry.Collectors() {
+		fields[c.Name] = c.Enabled
+	}
+
+	return structpb.NewStruct(fields)
+}
+
+func (s *grpcService) enableCollector(_ context.Context, req proto.Message) (proto.Message, error) {
+	return &emptypb.Empty{}, grpcError(s.registry.SetEnabled(req.(*wrapperspb.StringValue).GetValue(), true))
+}
+
+func (s *grpcService) disableCollector(_ context.Context, req proto.Message) (proto.Message, error) {
+	return &emptypb.Empty{}, grpcError(s.registry.SetEnabled(req.(*wrapperspb.StringValue).GetValue(), false))
+}
+
+func (s *grpcService) getInterval(_ context.Context, _ proto.Message) (proto.Message, error) {
+	interval, err := s.registry.Interval()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return durationpb.New(interval), nil
+}
+
+func (s *grpcService) setInterval(_ context.Context, req proto.Message) (proto.Message, error) {
+	d := req.(*durationpb.Duration)
+	if err := d.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &emptypb.Empty{}, grpcError(s.registry.SetInterval(d.AsDuration()))
+}
+
+func (s *grpcService) flush(ctx context.Context, _ proto.Message) (proto.Message, error) {
+	return &emptypb.Empty{}, grpcError(s.registry.Flush(ctx))
+}
+
+// unaryMethod creates the descriptor of a unary method decoding its request with
+// newReq, running the server interceptor and checking the authorization.
+func unaryMethod(name string, newReq func() proto.Message, call func(*grpcService, context.Context, proto.Message) (proto.Message, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			req := newReq()
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+
+			s := srv.(*grpcService)
+			handle := func(ctx context.Context, req any) (any, error) {
+				if s.auth == nil || !s.auth(ctx) {
+					return nil, status.Error(codes.PermissionDenied, "forbidden")
+				}
+				return call(s, ctx, req.(proto.Message))
+			}
+
+			if interceptor == nil {
+				return handle(ctx, req)
+			}
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + GRPCServiceName + "/" + name}, handle)
+		},
+	}
+}
+
+// grpcError converts the registry errors to gRPC status errors.
+func grpcError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrInvalidInterval):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrUnknownCollector):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrNoReader):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// registerDescriptor registers the descriptor of proto/admin.proto in the global
+// protobuf registry, making the service discoverable through gRPC reflection.
+func registerDescriptor() error {
+	if _, err := protoregistry.GlobalFiles.FindFileByPath(protoFile); err == nil {
+		return nil
+	}
+
+	method := func(name, in, out string) *descriptorpb.MethodDescriptorProto {
+		return &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(name),
+			InputType:  proto.String(".google.protobuf." + in),
+			OutputType: proto.String(".google.protobuf." + out),
+		}
+	}
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String(protoFile),
+		Package: proto.String("goxkit.metrics.admin.v1"),
+		Syntax:  proto.String("proto3"),
+		Dependency: []string{
+			"google/protobuf/duration.proto",
+			"google/protobuf/empty.proto",
+			"google/protobuf/struct.proto",
+			"google/protobuf/wrappers.proto",
+		},
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("github.com/goxkit/metrics/admin")},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("MetricsAdmin"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				method("ListCollectors", "Empty", "Struct"),
+				method("EnableCollector", "StringValue", "Empty"),
+				method("DisableCollector", "StringValue", "Empty"),
+				method("GetInterval", "Empty", "Duration"),
+				method("SetInterval", "Duration", "Empty"),
+				method("Flush", "Empty", "Empty"),
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		return err
+	}
+
+	return protoregistry.GlobalFiles.RegisterFile(fd)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+type (
+	// AuthFunc authorizes an admin request, returning false rejects it with 403.
+	AuthFunc func(r *http.Request) bool
+
+	// handler serves the admin HTTP API of a Registry.
+	handler struct {
+		registry *Registry
+		auth     AuthFunc
+		mux      *http.ServeMux
+	}
+
+	// intervalBody is the body of the interval endpoints.
+	intervalBody struct {
+		Interval string `json:"interval"`
+	}
+
+	// errorBody is the body of the error responses.
+	errorBody struct {
+		Error string `json:"error"`
+	}
+)
+
+// NewHandler creates the admin HTTP handler of the registry, to be mounted by the
+// application, for instance with http.StripPrefix("/admin/metrics", handler).
+// Every request must be authorized by auth, a nil auth rejects all requests.
+//
+// Routes:
+//   - GET /collectors lists the collectors
+//   - POST /collectors/{name}/enable and /collectors/{name}/disable toggle a collector
+//   - GET /interval returns the export interval, PUT /interval changes it with {"interval": "30s"}
+//   - POST /flush collects and exports the metrics immediately
+//
+// Parameters:
+//   - registry: The registry holding the runtime controls
+//   - auth: The authorization hook
+//
+// Returns:
+//   - The admin HTTP handler
+func NewHandler(registry *Registry, auth AuthFunc) http.Handler {
+	h := &handler{registry: registry, auth: auth, mux: http.NewServeMux()}
+
+	h.mux.HandleFunc("GET /collectors", h.listCollectors)
+	h.mux.HandleFunc("POST /collectors/{name}/enable", h.toggleCollector(true))
+	h.mux.HandleFunc("POST /collectors/{name}/disable", h.toggleCollector(false))
+	h.mux.HandleFunc("GET /interval", h.getInterval)
+	h.mux.HandleFunc("PUT /interval", h.setInterval)
+	h.mux.HandleFunc("POST /flush", h.flush)
+
+	return h
+}
+
+// ServeHTTP authorizes the request and routes it.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.auth == nil || !h.auth(r) {
+		writeJSON(w, http.StatusForbidden, errorBody{Error: "forbidden"})
+		return
+	}
+
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *handler) listCollectors(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, h.registry.Collectors())
+}
+
+func (h *handler) toggleCollector(enabled bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		if err := h.registry.SetEnabled(name, enabled); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, CollectorStatus{Name: name, Enabled: enabled})
+	}
+}
+
+func (h *handler) getInterval(w http.ResponseWriter, _ *http.Request) {
+	interval, err := h.registry.Interval()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, intervalBody{Interval: interval.String()})
+}
+
+func (h *handler) setInterval(w http.ResponseWriter, r *http.Request) {
+	var body intervalBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorBody{Error: err.Error()})
+		return
+	}
+
+	interval, err := time.ParseDuration(body.Interval)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorBody{Error: err.Error()})
+		return
+	}
+
+	if err := h.registry.SetInterval(interval); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, intervalBody{Interval: interval.String()})
+}
+
+func (h *handler) flush(w http.ResponseWriter, r *http.Request) {
+	if err := h.registry.Flush(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeError writes err with the status matching the registry errors.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrInvalidInterval):
+		status = http.StatusBadRequest
+	case errors.Is(err, ErrUnknownCollector):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrNoReader):
+		status = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, status, errorBody{Error: err.Error()})
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
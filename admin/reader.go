@@ -0,0 +1,180 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// DefaultInterval is the default export interval of the Reader, as the SDK periodic reader.
+	DefaultInterval = time.Minute
+
+	// DefaultTimeout bounds each periodic export of the Reader, as the SDK periodic reader.
+	DefaultTimeout = 30 * time.Second
+)
+
+// Reader is a periodic reader whose export interval can be changed at runtime.
+// It collects with an embedded manual reader and exports to the exporter. The
+// periodic exports and ForceFlush are serialized, the exporter is never called
+// concurrently.
+type Reader struct {
+	*sdkmetric.ManualReader
+	exporter sdkmetric.Exporter
+
+	// exportMu serializes the exports.
+	exportMu sync.Mutex
+
+	mu       sync.Mutex
+	interval time.Duration
+	reset    chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewReader creates a Reader exporting to exp every interval and starts its export loop.
+//
+// Parameters:
+//   - exp: The exporter receiving the collected metrics
+//   - interval: The export interval, DefaultInterval if not positive
+//   - producers: The external producers collected alongside the SDK metrics
+//
+// Returns:
+//   - A started Reader
+func NewReader(exp sdkmetric.Exporter, interval time.Duration, producers ...sdkmetric.Producer) *Reader {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	opts := []sdkmetric.ManualReaderOption{
+		sdkmetric.WithTemporalitySelector(exp.Temporality),
+		sdkmetric.WithAggregationSelector(exp.Aggregation),
+	}
+	for _, p := range producers {
+		opts = append(opts, sdkmetric.WithProducer(p))
+	}
+
+	r := &Reader{
+		ManualReader: sdkmetric.NewManualReader(opts...),
+		exporter:     exp,
+		interval:     interval,
+		reset:        make(chan struct{}, 1),
+		done:         make(chan struct{}),
+	}
+
+	r.wg.Add(1)
+	go r.run()
+
+	return r
+}
+
+// Interval returns the current export interval.
+func (r *Reader) Interval() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.interval
+}
+
+// SetInterval changes the export interval, the next export happens after the new
+// interval. It doesn't wait for an export in progress.
+//
+// Parameters:
+//   - interval: The new export interval, it must be positive
+//
+// Returns:
+//   - ErrInvalidInterval if interval isn't positive, or an error if the reader is shut down
+func (r *Reader) SetInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return ErrInvalidInterval
+	}
+
+	select {
+	case <-r.done:
+		return sdkmetric.ErrReaderShutdown
+	default:
+	}
+
+	r.mu.Lock()
+	r.interval = interval
+	r.mu.Unlock()
+
+	// Wake up the export loop, unless a reset is already pending
+	select {
+	case r.reset <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// ForceFlush collects and exports the metrics, then flushes the exporter.
+func (r *Reader) ForceFlush(ctx context.Context) error {
+	if err := r.export(ctx); err != nil {
+		return err
+	}
+	return r.exporter.ForceFlush(ctx)
+}
+
+// Shutdown stops the export loop, exports the pending metrics and shuts down the exporter.
+func (r *Reader) Shutdown(ctx context.Context) error {
+	err := sdkmetric.ErrReaderShutdown
+	r.stopOnce.Do(func() {
+		close(r.done)
+		r.wg.Wait()
+
+		err = errors.Join(
+			r.export(ctx),
+			r.ManualReader.Shutdown(ctx),
+			r.exporter.Shutdown(ctx),
+		)
+	})
+
+	return err
+}
+
+// run exports the metrics every interval until the reader is shut down. Each export
+// is bounded by DefaultTimeout, so a hung backend doesn't block the loop.
+func (r *Reader) run() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-r.reset:
+			ticker.Reset(r.Interval())
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+			if err := r.export(ctx); err != nil {
+				otel.Handle(err)
+			}
+			cancel()
+		}
+	}
+}
+
+// export collects the metrics and exports them, one export at a time.
+func (r *Reader) export(ctx context.Context) error {
+	r.exportMu.Lock()
+	defer r.exportMu.Unlock()
+
+	var rm metricdata.ResourceMetrics
+	if err := r.Collect(ctx, &rm); err != nil {
+		return err
+	}
+	return r.exporter.Export(ctx, &rm)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// countingExporter counts the exports and detects the concurrent ones.
+type countingExporter struct {
+	delay      time.Duration
+	exports    atomic.Int64
+	inflight   atomic.Int64
+	concurrent atomic.Bool
+	shutdown   atomic.Bool
+}
+
+func (e *countingExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (e *countingExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *countingExporter) Export(context.Context, *metricdata.ResourceMetrics) error {
+	if e.inflight.Add(1) > 1 {
+		e.concurrent.Store(true)
+	}
+	time.Sleep(e.delay)
+	e.inflight.Add(-1)
+	e.exports.Add(1)
+	return nil
+}
+
+func (e *countingExporter) ForceFlush(context.Context) error { return nil }
+
+func (e *countingExporter) Shutdown(context.Context) error {
+	e.shutdown.Store(true)
+	return nil
+}
+
+// newTestReader returns a Reader registered in a new MeterProvider.
+func newTestReader(t *testing.T, exp sdkmetric.Exporter, interval time.Duration) *Reader {
+	t.Helper()
+
+	reader := NewReader(exp, interval)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	return reader
+}
+
+// TestReaderSetInterval verifies that a new interval resets the export loop, instead
+// of waiting for the end of the current interval.
+func TestReaderSetInterval(t *testing.T) {
+	exp := &countingExporter{}
+	reader := newTestReader(t, exp, time.Hour)
+
+	if err := reader.SetInterval(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if got := reader.Interval(); got != 10*time.Millisecond {
+		t.Errorf("got interval %v, want 10ms", got)
+	}
+	if err := reader.SetInterval(0); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("got error %v, want ErrInvalidInterval", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for exp.exports.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if exp.exports.Load() == 0 {
+		t.Fatal("no export within a second of the new 10ms interval")
+	}
+}
+
+// TestReaderSerializedFlush verifies that the periodic exports and ForceFlush never
+// call the exporter concurrently.
+func TestReaderSerializedFlush(t *testing.T) {
+	exp := &countingExporter{delay: time.Millisecond}
+	reader := newTestReader(t, exp, time.Millisecond)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 5 {
+				if err := reader.ForceFlush(context.Background()); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if exp.concurrent.Load() {
+		t.Error("the exporter was called concurrently")
+	}
+	if got := exp.exports.Load(); got < 40 {
+		t.Errorf("got %d exports, want at least the 40 flushes", got)
+	}
+}
+
+// TestReaderShutdown verifies that Shutdown exports the pending metrics, shuts the
+// exporter down and stops the reader.
+func TestReaderShutdown(t *testing.T) {
+	ctx := context.Background()
+	exp := &countingExporter{}
+	reader := newTestReader(t, exp, time.Hour)
+
+	if err := reader.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if exp.exports.Load() != 1 || !exp.shutdown.Load() {
+		t.Errorf("got %d exports and shutdown %v, want the final export and the exporter shut down", exp.exports.Load(), exp.shutdown.Load())
+	}
+
+	if err := reader.Shutdown(ctx); !errors.Is(err, sdkmetric.ErrReaderShutdown) {
+		t.Errorf("got error %v on the second Shutdown, want ErrReaderShutdown", err)
+	}
+	if err := reader.SetInterval(time.Second); !errors.Is(err, sdkmetric.ErrReaderShutdown) {
+		t.Errorf("got error %v from SetInterval, want ErrReaderShutdown", err)
+	}
+}
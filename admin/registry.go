@@ -0,0 +1,281 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package admin provides runtime controls of the metrics pipeline: listing and
+// toggling collectors, changing the export interval and triggering a flush. The
+// controls are held by a Registry and exposed by an HTTP handler mountable by the
+// application.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/custom/system"
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+var (
+	// ErrUnknownCollector is returned when a collector is not registered.
+	ErrUnknownCollector = errors.New("unknown collector")
+	// ErrNoReader is returned when the registry reader isn't installed.
+	ErrNoReader = errors.New("admin reader not installed")
+	// ErrInvalidInterval is returned when the export interval isn't positive.
+	ErrInvalidInterval = errors.New("export interval must be positive")
+)
+
+type (
+	// Collector is a metrics collector that can be toggled at runtime.
+	Collector interface {
+		// Enable starts the collection, it is a no-op if already enabled.
+		Enable() error
+		// Disable stops the collection, it is a no-op if already disabled.
+		Disable() error
+		// Enabled reports whether the collector is enabled.
+		Enabled() bool
+	}
+
+	// CollectorStatus describes a registered collector.
+	CollectorStatus struct {
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+	}
+
+	// Registry holds the runtime controls of the metrics pipeline.
+	// It is safe for concurrent use.
+	Registry struct {
+		mu         sync.RWMutex
+		collectors map[string]Collector
+		reader     *Reader
+	}
+
+	// callbackCollector toggles an observable callback registration.
+	callbackCollector struct {
+		mu           sync.Mutex
+		register     func() (metric.Registration, error)
+		registration metric.Registration
+	}
+)
+
+// defaultRegistry is the registry used by the package level functions.
+var defaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry.
+//
+// Returns:
+//   - A new Registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: map[string]Collector{}}
+}
+
+// DefaultRegistry returns the registry used by the package level functions.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register registers a collector under name, replacing any collector with the same name.
+//
+// Parameters:
+//   - name: The collector name
+//   - c: The collector
+func (r *Registry) Register(name string, c Collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.collectors[name] = c
+}
+
+// RegisterSystem registers the collectors of result, created by
+// system.BasicMetricsCollector, under their group name, such as "mem" or "cpu".
+//
+// Parameters:
+//   - result: The collectors registered by system.BasicMetricsCollector
+func (r *Registry) RegisterSystem(result *system.CollectorResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, t := range result.Toggles() {
+		r.collectors[name] = t
+	}
+}
+
+// Collectors returns the status of the registered collectors, sorted by name.
+func (r *Registry) Collectors() []CollectorStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	statuses := make([]CollectorStatus, 0, len(r.collectors))
+	for name, c := range r.collectors {
+		statuses = append(statuses, CollectorStatus{Name: name, Enabled: c.Enabled()})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	return statuses
+}
+
+// SetEnabled enables or disables the named collector.
+//
+// Parameters:
+//   - name: The collector name
+//   - enabled: Whether the collector must be enabled
+//
+// Returns:
+//   - ErrUnknownCollector if no collector is registered under name, or the toggle error
+func (r *Registry) SetEnabled(name string, enabled bool) error {
+	r.mu.RLock()
+	c, ok := r.collectors[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCollector, name)
+	}
+
+	if enabled {
+		return c.Enable()
+	}
+	return c.Disable()
+}
+
+// Options returns the Install options replacing the exporting reader with a
+// Reader controlled by the registry.
+//
+// Parameters:
+//   - interval: The initial export interval, DefaultInterval if zero
+//
+// Returns:
+//   - The options to pass to metrics.Install
+func (r *Registry) Options(interval time.Duration) []options.Option {
+	return []options.Option{
+		options.WithReaderFactory(func(exp sdkmetric.Exporter, producers ...sdkmetric.Producer) sdkmetric.Reader {
+			reader := NewReader(exp, interval, producers...)
+
+			r.mu.Lock()
+			r.reader = reader
+			r.mu.Unlock()
+
+			return reader
+		}),
+	}
+}
+
+// Interval returns the current export interval.
+//
+// Returns:
+//   - The export interval
+//   - ErrNoReader if the registry reader isn't installed
+func (r *Registry) Interval() (time.Duration, error) {
+	reader, err := r.currentReader()
+	if err != nil {
+		return 0, err
+	}
+	return reader.Interval(), nil
+}
+
+// SetInterval changes the export interval.
+//
+// Parameters:
+//   - interval: The new export interval, it must be positive
+//
+// Returns:
+//   - ErrNoReader if the registry reader isn't installed, or ErrInvalidInterval
+func (r *Registry) SetInterval(interval time.Duration) error {
+	reader, err := r.currentReader()
+	if err != nil {
+		return err
+	}
+	return reader.SetInterval(interval)
+}
+
+// Flush collects and exports the metrics immediately.
+//
+// Parameters:
+//   - ctx: The context of the export
+//
+// Returns:
+//   - ErrNoReader if the registry reader isn't installed, or the export error
+func (r *Registry) Flush(ctx context.Context) error {
+	reader, err := r.currentReader()
+	if err != nil {
+		return err
+	}
+	return reader.ForceFlush(ctx)
+}
+
+// currentReader returns the installed reader.
+func (r *Registry) currentReader() (*Reader, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.reader == nil {
+		return nil, ErrNoReader
+	}
+	return r.reader, nil
+}
+
+// CallbackCollector creates a Collector toggling an observable callback: enabling
+// calls register and disabling unregisters the returned registration. The
+// collector is initially disabled.
+//
+// Parameters:
+//   - register: The function registering the callback, for instance with meter.RegisterCallback
+//
+// Returns:
+//   - A new Collector
+func CallbackCollector(register func() (metric.Registration, error)) Collector {
+	return &callbackCollector{register: register}
+}
+
+func (c *callbackCollector) Enable() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.registration != nil {
+		return nil
+	}
+
+	reg, err := c.register()
+	if err != nil {
+		return err
+	}
+	c.registration = reg
+
+	return nil
+}
+
+func (c *callbackCollector) Disable() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.registration == nil {
+		return nil
+	}
+
+	err := c.registration.Unregister()
+	c.registration = nil
+
+	return err
+}
+
+func (c *callbackCollector) Enabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.registration != nil
+}
+
+// Register registers a collector in the default registry.
+func Register(name string, c Collector) {
+	defaultRegistry.Register(name, c)
+}
+
+// RegisterSystem registers the system collectors of result in the default registry.
+func RegisterSystem(result *system.CollectorResult) {
+	defaultRegistry.RegisterSystem(result)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/goxkit/metrics/internal/window"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// Aggregation is a custom client-side aggregation plugged into the collection cycle,
+	// for approximate analytics metrics that raw counters can't express, such as the
+	// distinct count of user IDs per interval. The application feeds it with its own
+	// methods, and the aggregated values are exported as a gauge. Only the collections
+	// of the exporting reader, through WindowProducer, reset the aggregation, so the
+	// other readers of the MeterProvider don't shorten the exported intervals.
+	//
+	// Implementations must be safe for concurrent use.
+	Aggregation interface {
+		// Collect reports the values aggregated since the previous collection, one per
+		// attribute set, then resets the aggregation for the next interval.
+		Collect(report func(value float64, opts ...metric.ObserveOption))
+	}
+
+	// AggregationFunc adapts a function to the Aggregation interface.
+	AggregationFunc func(report func(value float64, opts ...metric.ObserveOption))
+
+	// windowRegistration unregisters a window from the WindowProducer.
+	windowRegistration struct {
+		embedded.Registration
+		unregister func()
+	}
+)
+
+// Collect calls f.
+func (f AggregationFunc) Collect(report func(value float64, opts ...metric.ObserveOption)) {
+	f(report)
+}
+
+// WindowProducer returns the producer of the windowed instruments: the custom
+// aggregations, the distinct counters, the interval summaries and the extrema of the
+// precision histograms, produced in the github.com/goxkit/metrics instrumentation
+// scope. Each collection of the producer reports the values of the interval since
+// its previous collection, then resets them.
+//
+// Install registers it in the exporting reader only, so the other readers, such as
+// the Prometheus handler or the diagnostics endpoint, neither see nor reset the
+// windows. Register it in the exporting reader of a MeterProvider created without
+// Install, with sdkmetric.WithProducer.
+//
+// Returns:
+//   - The producer of the windowed instruments
+func WindowProducer() sdkmetric.Producer {
+	return window.Default
+}
+
+// RegisterAggregation exports the values of a custom aggregation as a gauge with the
+// given name, collected by the exporting reader through WindowProducer. The gauge
+// isn't created by the Recorder meter, it is produced in the github.com/goxkit/metrics
+// scope.
+//
+// Parameters:
+//   - name: The gauge name
+//   - aggregation: The custom aggregation
+//   - opts: The gauge options, such as the description and unit
+//
+// Returns:
+//   - A registration that can be used to stop collecting the aggregation
+//   - An error wrapping window.ErrDuplicate if a window already exports the gauge
+func (r *Recorder) RegisterAggregation(name string, aggregation Aggregation, opts ...metric.Float64ObservableGaugeOption) (metric.Registration, error) {
+	cfg := metric.NewFloat64ObservableGaugeConfig(opts...)
+
+	unregister, err := window.Default.Register(instrumentationScope, []string{name}, func(start, now time.Time) []metricdata.Metrics {
+		var dps []metricdata.DataPoint[float64]
+		aggregation.Collect(func(value float64, opts ...metric.ObserveOption) {
+			dps = append(dps, windowPoint(metric.NewObserveConfig(opts).Attributes(), start, now, value))
+		})
+		if len(dps) == 0 {
+			return nil
+		}
+		return []metricdata.Metrics{window.Gauge(name, cfg.Description(), cfg.Unit(), dps)}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return windowRegistration{unregister: unregister}, nil
+}
+
+// RegisterAggregation exports the values of a custom aggregation as a gauge using the default Recorder.
+func RegisterAggregation(name string, aggregation Aggregation, opts ...metric.Float64ObservableGaugeOption) (metric.Registration, error) {
+	return defaultRecorder.RegisterAggregation(name, aggregation, opts...)
+}
+
+// Unregister stops producing the window.
+func (w windowRegistration) Unregister() error {
+	w.unregister()
+	return nil
+}
+
+// windowPoint returns the data point of a window value.
+func windowPoint[N int64 | float64](set attribute.Set, start, now time.Time, value N) metricdata.DataPoint[N] {
+	return metricdata.DataPoint[N]{Attributes: set, StartTime: start, Time: now, Value: value}
+}
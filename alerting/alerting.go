@@ -0,0 +1,303 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package alerting provides lightweight in-process threshold alerting for edge
+// deployments with no external alerting system. Thresholds are evaluated on the
+// metrics about to be exported and breaches are sent to a notifier, such as a webhook.
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/internal/aggregate"
+	"github.com/goxkit/metrics/processor"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Comparators supported by thresholds.
+const (
+	GreaterThan    Comparator = ">"
+	GreaterOrEqual Comparator = ">="
+	LessThan       Comparator = "<"
+	LessOrEqual    Comparator = "<="
+	Equal          Comparator = "=="
+	NotEqual       Comparator = "!="
+)
+
+// Alert states reported to the notifier.
+const (
+	StateFiring   State = "firing"
+	StateResolved State = "resolved"
+)
+
+type (
+	// Comparator compares the metric value to the threshold value.
+	Comparator string
+
+	// State is the state of an alert.
+	State string
+
+	// Threshold defines an alert raised when a metric value compares to Value for
+	// at least Duration.
+	Threshold struct {
+		// Name identifies the alert, the metric name is used if empty.
+		Name string `json:"name,omitempty"`
+		// Metric is the watched metric name.
+		Metric string `json:"metric"`
+		// Attributes restrict the data points of the metric, all data points if empty.
+		Attributes map[string]string `json:"attributes,omitempty"`
+		// Comparator compares the metric value to Value.
+		Comparator Comparator `json:"comparator"`
+		// Value is the threshold value.
+		Value float64 `json:"value"`
+		// Duration is how long the condition must hold before the alert fires.
+		Duration time.Duration `json:"-"`
+	}
+
+	// Alert is a notification sent when a threshold fires or resolves.
+	Alert struct {
+		Name       string     `json:"name"`
+		Metric     string     `json:"metric"`
+		State      State      `json:"state"`
+		Comparator Comparator `json:"comparator"`
+		Threshold  float64    `json:"threshold"`
+		Value      float64    `json:"value"`
+		Since      time.Time  `json:"since"`
+		Time       time.Time  `json:"time"`
+	}
+
+	// Notifier receives the alerts.
+	Notifier interface {
+		// Notify sends the alert.
+		Notify(ctx context.Context, alert Alert) error
+	}
+
+	// NotifierFunc is an adapter allowing ordinary functions to be used as notifiers.
+	NotifierFunc func(ctx context.Context, alert Alert) error
+
+	// Evaluator evaluates the thresholds on the metrics about to be exported. It
+	// implements processor.Hook and is registered with processor.Wrap.
+	Evaluator struct {
+		thresholds []Threshold
+		notifier   Notifier
+
+		mu     sync.Mutex
+		states []thresholdState
+		deltas *aggregate.Deltas
+	}
+
+	// thresholdState tracks the evaluation of a threshold.
+	thresholdState struct {
+		since  time.Time
+		firing bool
+	}
+)
+
+var _ processor.Hook = (*Evaluator)(nil)
+
+// Notify calls f(ctx, alert).
+func (f NotifierFunc) Notify(ctx context.Context, alert Alert) error {
+	return f(ctx, alert)
+}
+
+// New creates an Evaluator sending the alerts of the thresholds to the notifier.
+//
+// Parameters:
+//   - notifier: The notifier receiving the alerts, for instance Webhook
+//   - thresholds: The thresholds to evaluate
+//
+// Returns:
+//   - A new Evaluator
+//   - An error if a threshold is invalid
+func New(notifier Notifier, thresholds ...Threshold) (*Evaluator, error) {
+	thresholds = append([]Threshold(nil), thresholds...)
+	for i, t := range thresholds {
+		if t.Metric == "" {
+			return nil, fmt.Errorf("alerting: threshold %d has no metric", i)
+		}
+		if _, ok := compare(t.Comparator, 0, 0); !ok {
+			return nil, fmt.Errorf("alerting: threshold %d has an invalid comparator %q", i, t.Comparator)
+		}
+		if t.Name == "" {
+			thresholds[i].Name = t.Metric
+		}
+	}
+
+	return &Evaluator{
+		thresholds: thresholds,
+		notifier:   notifier,
+		states:     make([]thresholdState, len(thresholds)),
+		deltas:     aggregate.NewDeltas(),
+	}, nil
+}
+
+// ParseThresholds parses a JSON array of thresholds. The duration is given as a
+// Go duration string in the "duration" field, for example:
+//
+//	[{"metric": "http.request.duration", "comparator": ">", "value": 0.5, "duration": "5m"}]
+//
+// Parameters:
+//   - data: The JSON document
+//
+// Returns:
+//   - The parsed thresholds
+//   - An error if the document or a duration is invalid
+func ParseThresholds(data []byte) ([]Threshold, error) {
+	var raw []struct {
+		Threshold
+		Duration string `json:"duration"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	thresholds := make([]Threshold, 0, len(raw))
+	for _, r := range raw {
+		t := r.Threshold
+		if r.Duration != "" {
+			d, err := time.ParseDuration(r.Duration)
+			if err != nil {
+				return nil, fmt.Errorf("alerting: invalid duration of %s: %w", t.Metric, err)
+			}
+			t.Duration = d
+		}
+		thresholds = append(thresholds, t)
+	}
+
+	return thresholds, nil
+}
+
+// Process evaluates the thresholds on the metrics about to be exported. Gauges and
+// up-down counters compare the sum of their matching data points, counters their
+// increase since the previous export and histograms the mean of the values recorded
+// since the previous export, whatever the temporality. Thresholds whose metric is
+// absent, or without new histogram values, keep their state, as well as the first
+// export of the cumulative counters and histograms.
+// Notifications are sent asynchronously and their errors are reported to the
+// OpenTelemetry error handler, so the export is never delayed.
+func (e *Evaluator) Process(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	now := time.Now()
+
+	var alerts []Alert
+
+	e.mu.Lock()
+	for i, t := range e.thresholds {
+		v, ok := e.metricValue(rm, i)
+		if !ok {
+			continue
+		}
+
+		breached, _ := compare(t.Comparator, v, t.Value)
+		s := &e.states[i]
+
+		switch {
+		case breached && s.since.IsZero():
+			s.since = now
+		case !breached && s.firing:
+			alerts = append(alerts, newAlert(t, StateResolved, v, s.since, now))
+			*s = thresholdState{}
+		case !breached:
+			*s = thresholdState{}
+		}
+
+		if breached && !s.firing && now.Sub(s.since) >= t.Duration {
+			s.firing = true
+			alerts = append(alerts, newAlert(t, StateFiring, v, s.since, now))
+		}
+	}
+	e.mu.Unlock()
+
+	if len(alerts) > 0 {
+		go e.notify(context.WithoutCancel(ctx), alerts)
+	}
+
+	return nil
+}
+
+// notify sends the alerts, reporting the failures to the OpenTelemetry error handler.
+func (e *Evaluator) notify(ctx context.Context, alerts []Alert) {
+	for _, a := range alerts {
+		if err := e.notifier.Notify(ctx, a); err != nil {
+			otel.Handle(fmt.Errorf("alerting: notify %s: %w", a.Name, err))
+		}
+	}
+}
+
+// newAlert creates the alert of the threshold.
+func newAlert(t Threshold, state State, value float64, since, now time.Time) Alert {
+	return Alert{
+		Name:       t.Name,
+		Metric:     t.Metric,
+		State:      state,
+		Comparator: t.Comparator,
+		Threshold:  t.Value,
+		Value:      value,
+		Since:      since,
+		Time:       now,
+	}
+}
+
+// compare compares v to threshold, reporting false as second value for unknown comparators.
+func compare(c Comparator, v, threshold float64) (bool, bool) {
+	switch c {
+	case GreaterThan:
+		return v > threshold, true
+	case GreaterOrEqual:
+		return v >= threshold, true
+	case LessThan:
+		return v < threshold, true
+	case LessOrEqual:
+		return v <= threshold, true
+	case Equal:
+		return v == threshold, true
+	case NotEqual:
+		return v != threshold, true
+	}
+	return false, false
+}
+
+// metricValue computes the value of the threshold metric, without value on the first
+// export of a cumulative counter or histogram.
+func (e *Evaluator) metricValue(rm *metricdata.ResourceMetrics, i int) (float64, bool) {
+	t := e.thresholds[i]
+	match := func(attrs attribute.Set) bool { return matches(attrs, t.Attributes) }
+
+	var totals aggregate.Totals
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != t.Metric {
+				continue
+			}
+			if mt, ok := aggregate.Total(m.Data, match); ok {
+				totals.Add(mt)
+			}
+		}
+	}
+	if totals.Points == 0 {
+		return 0, false
+	}
+
+	totals, ok := e.deltas.Delta(strconv.Itoa(i), totals)
+	if !ok {
+		return 0, false
+	}
+	return totals.Reduce()
+}
+
+// matches reports whether the data point attributes contain all the filter attributes.
+func matches(attrs attribute.Set, filter map[string]string) bool {
+	for k, v := range filter {
+		if value, ok := attrs.Value(attribute.Key(k)); !ok || value.Emit() != v {
+			return false
+		}
+	}
+	return true
+}
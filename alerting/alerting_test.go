@@ -0,0 +1,60 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package alerting
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collection returns a collection with a cumulative counter and a cumulative histogram.
+func collection(requests int64, latencySum float64, latencyCount uint64) *metricdata.ResourceMetrics {
+	return &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{
+		{Name: "requests", Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: requests}},
+		}},
+		{Name: "latency", Data: metricdata.Histogram[float64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.HistogramDataPoint[float64]{{Sum: latencySum, Count: latencyCount}},
+		}},
+	}}}}
+}
+
+// TestMetricValueCumulative verifies that the cumulative counters and histograms are
+// evaluated on their values since the previous export.
+func TestMetricValueCumulative(t *testing.T) {
+	e, err := New(NotifierFunc(func(context.Context, Alert) error { return nil }),
+		Threshold{Metric: "requests", Comparator: GreaterThan, Value: 10},
+		Threshold{Metric: "latency", Comparator: GreaterThan, Value: 1},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exports := []struct {
+		rm       *metricdata.ResourceMetrics
+		requests float64
+		latency  float64
+		ok       bool
+	}{
+		{rm: collection(1000, 400, 100)},
+		{rm: collection(1005, 420, 110), requests: 5, latency: 2, ok: true},
+		{rm: collection(1025, 425, 120), requests: 20, latency: 0.5, ok: true},
+	}
+	for i, export := range exports {
+		requests, ok := e.metricValue(export.rm, 0)
+		if ok != export.ok || requests != export.requests {
+			t.Errorf("export %d: got requests %v %v, want %v %v", i, requests, ok, export.requests, export.ok)
+		}
+		latency, ok := e.metricValue(export.rm, 1)
+		if ok != export.ok || latency != export.latency {
+			t.Errorf("export %d: got latency %v %v, want %v %v", i, latency, ok, export.latency, export.ok)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// defaultWebhookTimeout is the timeout of the webhook requests when no client is given.
+const defaultWebhookTimeout = 10 * time.Second
+
+// webhook posts the alerts as JSON to an URL.
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+// Webhook creates a Notifier posting every alert as a JSON document to url.
+//
+// Parameters:
+//   - url: The webhook URL
+//   - client: The HTTP client sending the requests, a client with a 10s timeout if nil
+//
+// Returns:
+//   - A Notifier posting the alerts
+func Webhook(url string, client *http.Client) Notifier {
+	if client == nil {
+		client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+
+	return &webhook{url: url, client: client}
+}
+
+// Notify posts the alert, failing on non 2xx responses.
+func (w *webhook) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// CurrencyKey is the attribute key of the ISO 4217 currency code of the recorded amounts.
+const CurrencyKey = attribute.Key("currency")
+
+// ErrInvalidAmount is reported to the OpenTelemetry error handler when an amount is
+// negative or recorded with a currency that is not an ISO 4217 code.
+var ErrInvalidAmount = errors.New("metrics: invalid amount")
+
+// iso4217 holds the active ISO 4217 currency codes.
+var iso4217 = func() map[string]struct{} {
+	codes := map[string]struct{}{}
+	for _, code := range strings.Fields(`
+		AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV
+		BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUP CVE CZK
+		DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD HNL
+		HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD KZT
+		LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR
+		MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB RWF
+		SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS TMT TND TOP
+		TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF XCD XCG
+		XOF XPF YER ZAR ZMW ZWG`) {
+		codes[code] = struct{}{}
+	}
+	return codes
+}()
+
+// ValidCurrency reports whether code is an active ISO 4217 currency code, such as "USD".
+// Codes are case-sensitive, lowercase codes are rejected so a currency is never
+// exported as two series.
+//
+// Parameters:
+//   - code: The currency code
+//
+// Returns:
+//   - true if code is an ISO 4217 currency code
+func ValidCurrency(code string) bool {
+	_, ok := iso4217[code]
+	return ok
+}
+
+// Amount adds a monetary amount to the counter with the given name. The amount is
+// expressed as an integer number of minor units of the currency, such as cents for
+// "USD", so revenue sums are exact where float summation would drift.
+//
+// The currency is recorded as the CurrencyKey attribute. Negative amounts and amounts
+// with an invalid currency are dropped and reported to the OpenTelemetry error handler
+// as ErrInvalidAmount, refunds should be counted by a counter of their own.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - name: The counter name, such as "orders.revenue"
+//   - minorUnits: The amount in minor units of the currency
+//   - currency: The ISO 4217 currency code
+//   - attrs: The attributes of the measurement
+func (r *Recorder) Amount(ctx context.Context, name string, minorUnits int64, currency string, attrs ...attribute.KeyValue) {
+	if minorUnits < 0 {
+		otel.Handle(fmt.Errorf("%w: negative amount %d for %s", ErrInvalidAmount, minorUnits, name))
+		return
+	}
+	if !ValidCurrency(currency) {
+		otel.Handle(fmt.Errorf("%w: unknown currency %q for %s", ErrInvalidAmount, currency, name))
+		return
+	}
+
+	counter, err := cachedInstrument(r, "amount:"+name, func() (metric.Int64Counter, error) {
+		return r.meter.Int64Counter(name, metric.WithUnit("{minor_unit}"))
+	})
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	attrs = append(attrs[:len(attrs):len(attrs)], CurrencyKey.String(currency))
+	counter.Add(ctx, minorUnits, metric.WithAttributes(r.attributes(ctx, attrs)...))
+}
+
+// Amount adds a monetary amount, in minor units of the currency, to the named counter
+// using the default Recorder.
+func Amount(ctx context.Context, name string, minorUnits int64, currency string, attrs ...attribute.KeyValue) {
+	defaultRecorder.Amount(ctx, name, minorUnits, currency, attrs...)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package anomaly provides a simple in-process anomaly detector. It watches selected
+// metrics before they are exported, tracks an exponentially weighted moving average
+// (EWMA) and variance of their values and increments the anomaly.detected counter
+// when a value deviates from the average by more than a z-score threshold.
+package anomaly
+
+import (
+	"context"
+	"math"
+	"path"
+	"sync"
+
+	"github.com/goxkit/metrics/internal/aggregate"
+	"github.com/goxkit/metrics/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// DefaultAlpha is the default EWMA smoothing factor.
+	DefaultAlpha = 0.3
+	// DefaultThreshold is the default z-score above which a value is anomalous.
+	DefaultThreshold = 3.0
+	// DefaultWarmup is the default number of values observed before detecting anomalies.
+	DefaultWarmup = 10
+)
+
+type (
+	// Config configures a Detector.
+	Config struct {
+		// Metrics are the name patterns of the watched metrics, using the path.Match
+		// syntax, for example "http.*".
+		Metrics []string
+		// Alpha is the EWMA smoothing factor in (0, 1]. Higher values adapt faster.
+		// Default: DefaultAlpha
+		Alpha float64
+		// Threshold is the z-score above which a value is anomalous. Default: DefaultThreshold
+		Threshold float64
+		// Warmup is the number of values observed before detecting anomalies. Default: DefaultWarmup
+		Warmup int
+	}
+
+	// Detector detects anomalies on the watched metrics. It implements processor.Hook
+	// and is registered with processor.Wrap. It is safe for concurrent use.
+	Detector struct {
+		cfg      Config
+		detected metric.Int64Counter
+
+		mu     sync.Mutex
+		stats  map[string]*ewma
+		deltas *aggregate.Deltas
+	}
+
+	// ewma holds the moving average and variance of a metric.
+	ewma struct {
+		mean     float64
+		variance float64
+		count    int
+	}
+)
+
+var _ processor.Hook = (*Detector)(nil)
+
+// New creates a Detector whose counter is created with the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the anomaly.detected counter
+//   - cfg: The watched metrics and detection settings
+//
+// Returns:
+//   - A new Detector
+//   - An error if the counter cannot be created
+func New(meter metric.Meter, cfg Config) (*Detector, error) {
+	if cfg.Alpha <= 0 || cfg.Alpha > 1 {
+		cfg.Alpha = DefaultAlpha
+	}
+	if cfg.Threshold <= 0 {
+		cfg.Threshold = DefaultThreshold
+	}
+	if cfg.Warmup <= 0 {
+		cfg.Warmup = DefaultWarmup
+	}
+
+	detected, err := meter.Int64Counter("anomaly.detected", metric.WithDescription("Number of anomalous values detected on the watched metrics."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Detector{
+		cfg:      cfg,
+		detected: detected,
+		stats:    map[string]*ewma{},
+		deltas:   aggregate.NewDeltas(),
+	}, nil
+}
+
+// Observe feeds a value of the named metric to the detector, incrementing the
+// anomaly.detected counter if it is anomalous.
+//
+// Parameters:
+//   - ctx: The context used to record the counter
+//   - name: The metric name, reported as the metric attribute
+//   - value: The observed value
+//
+// Returns:
+//   - Whether the value is anomalous
+func (d *Detector) Observe(ctx context.Context, name string, value float64) bool {
+	d.mu.Lock()
+	anomalous := d.update(name, value)
+	d.mu.Unlock()
+
+	if anomalous {
+		d.detected.Add(ctx, 1, metric.WithAttributes(attribute.String("metric", name)))
+	}
+
+	return anomalous
+}
+
+// Process observes the value of every watched metric about to be exported.
+// Counters contribute their increase since the previous export, histograms the
+// mean of the values recorded since the previous export and gauges the sum of
+// their data points.
+func (d *Detector) Process(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if !d.watched(m.Name) {
+				continue
+			}
+
+			if v, ok := d.value(m); ok {
+				d.Observe(ctx, m.Name, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// update updates the moving statistics of the metric, reporting whether value is
+// anomalous given the statistics before the update.
+func (d *Detector) update(name string, value float64) bool {
+	s, ok := d.stats[name]
+	if !ok {
+		d.stats[name] = &ewma{mean: value, count: 1}
+		return false
+	}
+
+	diff := value - s.mean
+	anomalous := false
+	if s.count >= d.cfg.Warmup {
+		if std := math.Sqrt(s.variance); std > 0 {
+			anomalous = math.Abs(diff)/std > d.cfg.Threshold
+		} else {
+			anomalous = diff != 0
+		}
+	}
+
+	s.mean += d.cfg.Alpha * diff
+	s.variance = (1 - d.cfg.Alpha) * (s.variance + d.cfg.Alpha*diff*diff)
+	s.count++
+
+	return anomalous
+}
+
+// watched reports whether the metric matches a watched pattern.
+func (d *Detector) watched(name string) bool {
+	for _, p := range d.cfg.Metrics {
+		if ok, err := path.Match(p, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// value computes the observed value of the metric, without value on the first
+// export of a cumulative metric.
+func (d *Detector) value(m metricdata.Metrics) (float64, bool) {
+	t, ok := aggregate.Total(m.Data, nil)
+	if !ok {
+		return 0, false
+	}
+	if t, ok = d.deltas.Delta(m.Name, t); !ok {
+		return 0, false
+	}
+	return t.Reduce()
+}
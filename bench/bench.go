@@ -0,0 +1,205 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package bench exports Go benchmark results as metrics, so performance CI results
+// land in the same backend as the production metrics. Results are parsed from the
+// `go test -bench` output or built from testing.BenchmarkResult values, then recorded
+// as gauges by the configured MeterProvider.
+package bench
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Result is the result of a benchmark.
+type Result struct {
+	// Name is the benchmark name without the GOMAXPROCS suffix, such as "BenchmarkEncode/small".
+	Name string
+	// Package is the import path of the benchmarked package, if known.
+	Package string
+	// Procs is the GOMAXPROCS value of the run.
+	Procs int
+	// Iterations is the number of iterations of the run.
+	Iterations int
+	// Metrics maps the units, such as "ns/op", "B/op" or "allocs/op", to their value.
+	Metrics map[string]float64
+	// Env holds the context lines of the output, such as goos, goarch and cpu.
+	Env map[string]string
+}
+
+// Parse parses the output of `go test -bench`, the result lines and the context
+// lines (goos, goarch, pkg, cpu) preceding them. Other lines are ignored.
+//
+// Parameters:
+//   - r: The benchmark output
+//
+// Returns:
+//   - The benchmark results in output order
+//   - An error if the output cannot be read
+func Parse(r io.Reader) ([]Result, error) {
+	var results []Result
+	env := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if key, value, ok := strings.Cut(line, ": "); ok && !strings.ContainsAny(key, " \t") {
+			switch key {
+			case "goos", "goarch", "pkg", "cpu":
+				// Copy on write, results keep the context of their own package
+				next := make(map[string]string, len(env)+1)
+				for k, v := range env {
+					next[k] = v
+				}
+				next[key] = strings.TrimSpace(value)
+				env = next
+			}
+			continue
+		}
+
+		if res, ok := parseLine(line); ok {
+			res.Package = env["pkg"]
+			res.Env = env
+			results = append(results, res)
+		}
+	}
+
+	return results, scanner.Err()
+}
+
+// parseLine parses a benchmark result line, such as
+// "BenchmarkEncode-8   1000000   1234 ns/op   512 B/op   3 allocs/op".
+func parseLine(line string) (Result, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") || len(fields)%2 != 0 {
+		return Result{}, false
+	}
+
+	iterations, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Result{}, false
+	}
+
+	res := Result{Name: fields[0], Procs: 1, Iterations: iterations, Metrics: map[string]float64{}}
+	if i := strings.LastIndexByte(res.Name, '-'); i > 0 {
+		if procs, err := strconv.Atoi(res.Name[i+1:]); err == nil {
+			res.Name, res.Procs = res.Name[:i], procs
+		}
+	}
+
+	for i := 2; i+1 < len(fields); i += 2 {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return Result{}, false
+		}
+		res.Metrics[fields[i+1]] = v
+	}
+
+	return res, true
+}
+
+// FromTesting converts a testing.BenchmarkResult, as returned by testing.Benchmark,
+// to a Result.
+//
+// Parameters:
+//   - name: The benchmark name
+//   - r: The benchmark result
+//
+// Returns:
+//   - The Result with the ns/op, B/op, allocs/op and custom metrics
+func FromTesting(name string, r testing.BenchmarkResult) Result {
+	res := Result{
+		Name:       name,
+		Procs:      1,
+		Iterations: r.N,
+		Metrics: map[string]float64{
+			"ns/op":     float64(r.NsPerOp()),
+			"B/op":      float64(r.AllocedBytesPerOp()),
+			"allocs/op": float64(r.AllocsPerOp()),
+		},
+	}
+	for unit, v := range r.Extra {
+		res.Metrics[unit] = v
+	}
+
+	return res
+}
+
+// Record records the results as benchmark.<unit> gauges, for instance
+// benchmark.ns_per_op, with the benchmark, package and procs attributes and the
+// context of the output. The MeterProvider must be flushed or shut down afterwards
+// for the values to be exported before the CI job ends.
+//
+// Parameters:
+//   - ctx: The context of the measurements
+//   - meter: The OpenTelemetry meter used to create the gauges
+//   - results: The results to record
+//
+// Returns:
+//   - An error if a gauge cannot be created
+func Record(ctx context.Context, meter metric.Meter, results ...Result) error {
+	gauges := map[string]metric.Float64Gauge{}
+
+	for _, res := range results {
+		attrs := []attribute.KeyValue{
+			attribute.String("benchmark", res.Name),
+			attribute.String("package", res.Package),
+			attribute.Int("procs", res.Procs),
+		}
+		for k, v := range res.Env {
+			if k != "pkg" {
+				attrs = append(attrs, attribute.String(k, v))
+			}
+		}
+		opt := metric.WithAttributes(attrs...)
+
+		for unit, v := range res.Metrics {
+			name := MetricName(unit)
+			gauge, ok := gauges[name]
+			if !ok {
+				var err error
+				if gauge, err = meter.Float64Gauge(name, metric.WithUnit(unit)); err != nil {
+					return err
+				}
+				gauges[name] = gauge
+			}
+
+			gauge.Record(ctx, v, opt)
+		}
+	}
+
+	return nil
+}
+
+// MetricName returns the gauge name of a benchmark unit, "ns/op" becomes
+// "benchmark.ns_per_op" and "MB/s" becomes "benchmark.mb_per_s".
+//
+// Parameters:
+//   - unit: The benchmark unit
+//
+// Returns:
+//   - The gauge name
+func MetricName(unit string) string {
+	unit = strings.ReplaceAll(strings.ToLower(unit), "/", "_per_")
+	unit = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, unit)
+	if unit == "b_per_op" {
+		unit = "bytes_per_op"
+	}
+
+	return "benchmark." + unit
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package buckets provides curated histogram bucket presets for the common latency
+// distributions, selectable by name in the HTTP middleware and Recorder options, so
+// teams share consistent, heatmap-friendly bucket layouts instead of inventing their own.
+package buckets
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// WebRequest suits the server latency of web requests, from 5ms to 10s.
+	WebRequest Preset = "web-request"
+	// DBQuery suits database and cache queries, from 0.5ms to 2.5s.
+	DBQuery Preset = "db-query"
+	// BackgroundJob suits background and batch jobs, from 100ms to 1h.
+	BackgroundJob Preset = "background-job"
+	// NetworkRTT suits network round trips, from 100µs to 500ms.
+	NetworkRTT Preset = "network-rtt"
+)
+
+// Preset is the name of a bucket layout.
+type Preset string
+
+// presets holds the bucket boundaries of the presets, in seconds.
+var presets = map[Preset][]float64{
+	WebRequest:    {0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	DBQuery:       {0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	BackgroundJob: {0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+	NetworkRTT:    {0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5},
+}
+
+// Lookup returns the preset with the given name, for instance read from the configuration.
+//
+// Parameters:
+//   - name: The preset name, such as "db-query"
+//
+// Returns:
+//   - The preset
+//   - false if no preset has this name
+func Lookup(name string) (Preset, bool) {
+	_, ok := presets[Preset(name)]
+	return Preset(name), ok
+}
+
+// Names returns the names of the presets, sorted.
+func Names() []string {
+	names := make([]string, 0, len(presets))
+	for p := range presets {
+		names = append(names, string(p))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Boundaries returns the bucket boundaries of the preset in seconds, the OpenTelemetry
+// semantic conventions unit of durations.
+//
+// Returns:
+//   - The bucket boundaries, nil for an unknown preset
+func (p Preset) Boundaries() []float64 {
+	return p.In(time.Second)
+}
+
+// In returns the bucket boundaries of the preset expressed in unit, for histograms
+// recording durations in another unit than the second.
+//
+// Parameters:
+//   - unit: The duration unit of the histogram, such as time.Millisecond
+//
+// Returns:
+//   - The bucket boundaries, nil for an unknown preset
+func (p Preset) In(unit time.Duration) []float64 {
+	seconds, ok := presets[p]
+	if !ok || unit <= 0 {
+		return nil
+	}
+
+	scale := float64(time.Second) / float64(unit)
+	boundaries := make([]float64, len(seconds))
+	for i, s := range seconds {
+		boundaries[i] = s * scale
+	}
+	return boundaries
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// InstrumentCache stores the instruments created by a Recorder, keyed by kind
+	// and name. Implementations must be safe for concurrent use. Evicting an
+	// instrument is harmless, the meter returns the same instrument when the
+	// Recorder creates it again.
+	InstrumentCache interface {
+		// Get returns the instrument stored for key.
+		Get(key string) (any, bool)
+		// Set stores the instrument for key.
+		Set(key string, instrument any)
+		// Len returns the number of instruments stored.
+		Len() int
+	}
+
+	// CacheStats holds the statistics of the instrument cache of a Recorder.
+	CacheStats struct {
+		// Size is the number of instruments in the cache.
+		Size int
+		// Hits is the number of lookups served by the cache.
+		Hits uint64
+		// Misses is the number of lookups that created an instrument.
+		Misses uint64
+	}
+
+	// mapCache is an unbounded InstrumentCache backed by a map.
+	mapCache struct {
+		mu    sync.RWMutex
+		items map[string]any
+	}
+
+	// lruCache is an InstrumentCache bounded to a maximum size, evicting the least
+	// recently used instrument.
+	lruCache struct {
+		mu      sync.Mutex
+		maxSize int
+		ll      *list.List
+		items   map[string]*list.Element
+	}
+
+	// lruEntry is an element of the lruCache list.
+	lruEntry struct {
+		key        string
+		instrument any
+	}
+)
+
+// HitRatio returns the share of lookups served by the cache, or zero without lookups.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// NewMapCache creates an unbounded InstrumentCache, the default of a Recorder.
+func NewMapCache() InstrumentCache {
+	return &mapCache{items: map[string]any{}}
+}
+
+func (c *mapCache) Get(key string) (any, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	inst, ok := c.items[key]
+	return inst, ok
+}
+
+func (c *mapCache) Set(key string, instrument any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items[key] = instrument
+}
+
+func (c *mapCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.items)
+}
+
+// NewLRUCache creates an InstrumentCache holding at most maxSize instruments,
+// bounding the memory used by Recorders recording many distinct instrument names.
+//
+// Parameters:
+//   - maxSize: The maximum number of instruments, at least 1
+//
+// Returns:
+//   - A bounded InstrumentCache
+func NewLRUCache(maxSize int) InstrumentCache {
+	return &lruCache{
+		maxSize: max(maxSize, 1),
+		ll:      list.New(),
+		items:   map[string]*list.Element{},
+	}
+}
+
+func (c *lruCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*lruEntry).instrument, true
+}
+
+func (c *lruCache) Set(key string, instrument any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		e.Value.(*lruEntry).instrument = instrument
+		c.ll.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, instrument: instrument})
+	if c.ll.Len() > c.maxSize {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+func (c *lruCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// RegisterCacheMetrics registers the metrics.recorder.cache.size and
+// metrics.recorder.cache.hit_ratio gauges reporting the statistics of the
+// Recorder instrument cache.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the gauges
+//
+// Returns:
+//   - A registration that can be used to stop reporting the statistics
+//   - An error if the gauges or their callback cannot be registered
+func (r *Recorder) RegisterCacheMetrics(meter metric.Meter) (metric.Registration, error) {
+	size, err := meter.Int64ObservableGauge("metrics.recorder.cache.size", metric.WithDescription("Number of instruments in the Recorder cache."))
+	if err != nil {
+		return nil, err
+	}
+
+	ratio, err := meter.Float64ObservableGauge("metrics.recorder.cache.hit_ratio", metric.WithDescription("Share of instrument lookups served by the Recorder cache."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	return meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		stats := r.CacheStats()
+		observer.ObserveInt64(size, int64(stats.Size))
+		observer.ObserveFloat64(ratio, stats.HitRatio())
+		return nil
+	}, size, ratio)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package catalog generates a machine-readable catalog of the instruments of the
+// application, with their names, types, units, descriptions and known attributes,
+// which teams can publish as their metrics contract.
+//
+// The generator wraps the global MeterProvider to record the instruments when they
+// are created, so the catalog lists them even before they record a value, including
+// the ones created by this module. A dedicated manual reader collects their streams:
+// the known attributes are the keys seen on their data points since the application
+// started, and the instruments created before the wrapping, or by another provider,
+// are listed once they recorded a value.
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// Catalog lists the instruments of the application.
+	Catalog struct {
+		Instruments []Instrument `json:"instruments"`
+	}
+
+	// Instrument describes an instrument of the catalog.
+	Instrument struct {
+		Name        string   `json:"name"`
+		Type        string   `json:"type"`
+		ValueType   string   `json:"valueType,omitempty"`
+		Unit        string   `json:"unit,omitempty"`
+		Description string   `json:"description,omitempty"`
+		Scope       string   `json:"scope"`
+		Attributes  []string `json:"attributes"`
+	}
+
+	// Generator generates catalogs from the instruments created through its wrapped
+	// MeterProvider and the metrics collected by its reader.
+	Generator struct {
+		reader *sdkmetric.ManualReader
+
+		mu          sync.Mutex
+		instruments map[instrumentKey]*entry
+	}
+
+	// instrumentKey identifies an instrument of the catalog.
+	instrumentKey struct {
+		scope string
+		name  string
+	}
+
+	// entry is an instrument of the catalog with the attribute keys seen so far.
+	entry struct {
+		instrument Instrument
+		keys       map[string]struct{}
+	}
+)
+
+// NewGenerator creates a Generator. It must be registered in the MeterProvider
+// with the options returned by Options.
+//
+// Returns:
+//   - A new Generator
+func NewGenerator() *Generator {
+	return &Generator{reader: sdkmetric.NewManualReader(), instruments: map[instrumentKey]*entry{}}
+}
+
+// Options returns the Install options registering the generator reader and wrapping
+// the global MeterProvider to record the instruments when they are created.
+//
+// Returns:
+//   - The options to pass to metrics.Install
+func (g *Generator) Options() []options.Option {
+	return []options.Option{
+		options.WithReaders(g.reader),
+		options.WithMeterProviderWrapper(g.WrapMeterProvider),
+	}
+}
+
+// WrapMeterProvider wraps provider to record the instruments created by its meters
+// in the catalog. It is registered by Options, use it when the MeterProvider isn't
+// installed by metrics.Install.
+//
+// Parameters:
+//   - provider: The MeterProvider creating the instruments
+//
+// Returns:
+//   - The MeterProvider recording the instruments
+func (g *Generator) WrapMeterProvider(provider metric.MeterProvider) metric.MeterProvider {
+	return &recordingProvider{MeterProvider: provider, g: g}
+}
+
+// Generate collects the metrics and builds the catalog of the instruments created so
+// far, merging the attribute keys seen since the start, sorted by instrument name.
+//
+// Parameters:
+//   - ctx: The context of the collection
+//
+// Returns:
+//   - The catalog of the instruments
+//   - An error if the metrics cannot be collected
+func (g *Generator) Generate(ctx context.Context) (*Catalog, error) {
+	var rm metricdata.ResourceMetrics
+	if err := g.reader.Collect(ctx, &rm); err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			typ, valueType, sets := describe(m.Data)
+			e := g.entry(Instrument{
+				Name:        m.Name,
+				Type:        typ,
+				ValueType:   valueType,
+				Unit:        m.Unit,
+				Description: m.Description,
+				Scope:       sm.Scope.Name,
+			})
+			// The collected type reflects the aggregation, such as an exponential histogram
+			e.instrument.Type = typ
+			for _, set := range sets {
+				for _, kv := range set.ToSlice() {
+					e.keys[string(kv.Key)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	c := &Catalog{Instruments: make([]Instrument, 0, len(g.instruments))}
+	for _, e := range g.instruments {
+		instrument := e.instrument
+		instrument.Attributes = sortedKeys(e.keys)
+		c.Instruments = append(c.Instruments, instrument)
+	}
+
+	sort.Slice(c.Instruments, func(i, j int) bool {
+		if c.Instruments[i].Name != c.Instruments[j].Name {
+			return c.Instruments[i].Name < c.Instruments[j].Name
+		}
+		return c.Instruments[i].Scope < c.Instruments[j].Scope
+	})
+
+	return c, nil
+}
+
+// record adds the instrument created through the wrapped MeterProvider to the catalog.
+func (g *Generator) record(instrument Instrument) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.entry(instrument)
+}
+
+// entry returns the entry of the instrument, adding it if missing. It must be called
+// with g.mu held.
+func (g *Generator) entry(instrument Instrument) *entry {
+	key := instrumentKey{scope: instrument.Scope, name: instrument.Name}
+	if e, ok := g.instruments[key]; ok {
+		return e
+	}
+
+	e := &entry{instrument: instrument, keys: map[string]struct{}{}}
+	g.instruments[key] = e
+	return e
+}
+
+// WriteJSON generates the catalog and writes it as indented JSON to w.
+//
+// Parameters:
+//   - ctx: The context of the collection
+//   - w: The writer receiving the JSON document
+//
+// Returns:
+//   - An error if the metrics cannot be collected or the document cannot be written
+func (g *Generator) WriteJSON(ctx context.Context, w io.Writer) error {
+	c, err := g.Generate(ctx)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
+
+// describe returns the instrument type, the value type and the attribute sets of the aggregation.
+func describe(data metricdata.Aggregation) (string, string, []attribute.Set) {
+	switch a := data.(type) {
+	case metricdata.Sum[int64]:
+		return sumType(a.IsMonotonic), "int64", sets(a.DataPoints)
+	case metricdata.Sum[float64]:
+		return sumType(a.IsMonotonic), "float64", sets(a.DataPoints)
+	case metricdata.Gauge[int64]:
+		return "gauge", "int64", sets(a.DataPoints)
+	case metricdata.Gauge[float64]:
+		return "gauge", "float64", sets(a.DataPoints)
+	case metricdata.Histogram[int64]:
+		return "histogram", "int64", histogramSets(a.DataPoints)
+	case metricdata.Histogram[float64]:
+		return "histogram", "float64", histogramSets(a.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		return "exponential_histogram", "int64", exponentialSets(a.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		return "exponential_histogram", "float64", exponentialSets(a.DataPoints)
+	case metricdata.Summary:
+		s := make([]attribute.Set, 0, len(a.DataPoints))
+		for _, dp := range a.DataPoints {
+			s = append(s, dp.Attributes)
+		}
+		return "summary", "float64", s
+	}
+
+	return "unknown", "", nil
+}
+
+// sumType returns the instrument type of a sum.
+func sumType(monotonic bool) string {
+	if monotonic {
+		return "counter"
+	}
+	return "updowncounter"
+}
+
+// sets returns the attribute sets of the sum and gauge data points.
+func sets[N int64 | float64](dps []metricdata.DataPoint[N]) []attribute.Set {
+	s := make([]attribute.Set, 0, len(dps))
+	for _, dp := range dps {
+		s = append(s, dp.Attributes)
+	}
+	return s
+}
+
+// histogramSets returns the attribute sets of the histogram data points.
+func histogramSets[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []attribute.Set {
+	s := make([]attribute.Set, 0, len(dps))
+	for _, dp := range dps {
+		s = append(s, dp.Attributes)
+	}
+	return s
+}
+
+// exponentialSets returns the attribute sets of the exponential histogram data points.
+func exponentialSets[N int64 | float64](dps []metricdata.ExponentialHistogramDataPoint[N]) []attribute.Set {
+	s := make([]attribute.Set, 0, len(dps))
+	for _, dp := range dps {
+		s = append(s, dp.Attributes)
+	}
+	return s
+}
+
+// sortedKeys returns the sorted attribute keys.
+func sortedKeys(seen map[string]struct{}) []string {
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
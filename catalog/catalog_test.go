@@ -0,0 +1,59 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package catalog
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestGenerateInstrumentsWithoutData verifies that the catalog lists the instruments
+// without data points and keeps the attribute keys seen by the previous collections.
+func TestGenerateInstrumentsWithoutData(t *testing.T) {
+	ctx := context.Background()
+	g := NewGenerator()
+	// With the delta temporality, the second collection has no data point
+	g.reader = sdkmetric.NewManualReader(sdkmetric.WithTemporalitySelector(func(sdkmetric.InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	}))
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(g.reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	meter := g.WrapMeterProvider(provider).Meter("test")
+	orders, err := meter.Int64Counter("orders", metric.WithUnit("{order}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := meter.Float64Histogram("latency", metric.WithDescription("Latency"), metric.WithUnit("s")); err != nil {
+		t.Fatal(err)
+	}
+
+	orders.Add(ctx, 1, metric.WithAttributes(attribute.String("region", "eu")))
+	if _, err := g.Generate(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := g.Generate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Instrument{
+		{Name: "latency", Type: "histogram", ValueType: "float64", Unit: "s", Description: "Latency", Scope: "test", Attributes: []string{}},
+		{Name: "orders", Type: "counter", ValueType: "int64", Unit: "{order}", Scope: "test", Attributes: []string{"region"}},
+	}
+	if !slices.EqualFunc(c.Instruments, want, func(a, b Instrument) bool {
+		return a.Name == b.Name && a.Type == b.Type && a.ValueType == b.ValueType && a.Unit == b.Unit &&
+			a.Description == b.Description && a.Scope == b.Scope && slices.Equal(a.Attributes, b.Attributes)
+	}) {
+		t.Errorf("got instruments %+v, want %+v", c.Instruments, want)
+	}
+}
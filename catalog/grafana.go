@@ -0,0 +1,388 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/goxkit/metrics/processor"
+)
+
+const (
+	// grafanaPanelWidth and grafanaPanelHeight size the panels, three per row.
+	grafanaPanelWidth  = 8
+	grafanaPanelHeight = 8
+	grafanaGridWidth   = 24
+
+	// rateInterval is the range of the rate queries, resolved by Grafana.
+	rateInterval = "$__rate_interval"
+)
+
+type (
+	// GrafanaDashboard is a Grafana dashboard JSON model.
+	GrafanaDashboard struct {
+		Title         string          `json:"title"`
+		UID           string          `json:"uid,omitempty"`
+		Tags          []string        `json:"tags"`
+		SchemaVersion int             `json:"schemaVersion"`
+		Time          GrafanaTime     `json:"time"`
+		Templating    GrafanaTemplate `json:"templating"`
+		Panels        []GrafanaPanel  `json:"panels"`
+	}
+
+	// GrafanaTime is the default time range of a dashboard.
+	GrafanaTime struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+
+	// GrafanaTemplate holds the template variables of a dashboard.
+	GrafanaTemplate struct {
+		List []GrafanaVariable `json:"list"`
+	}
+
+	// GrafanaVariable is a dashboard template variable.
+	GrafanaVariable struct {
+		Name  string `json:"name"`
+		Label string `json:"label,omitempty"`
+		Type  string `json:"type"`
+		Query string `json:"query"`
+	}
+
+	// GrafanaPanel is a row or a time series panel of a dashboard.
+	GrafanaPanel struct {
+		ID          int                `json:"id"`
+		Type        string             `json:"type"`
+		Title       string             `json:"title"`
+		Description string             `json:"description,omitempty"`
+		GridPos     GrafanaGridPos     `json:"gridPos"`
+		Datasource  *GrafanaDatasource `json:"datasource,omitempty"`
+		Targets     []GrafanaTarget    `json:"targets,omitempty"`
+		FieldConfig *GrafanaFieldConf  `json:"fieldConfig,omitempty"`
+	}
+
+	// GrafanaGridPos is the position and size of a panel.
+	GrafanaGridPos struct {
+		H int `json:"h"`
+		W int `json:"w"`
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	// GrafanaDatasource references the datasource of a panel.
+	GrafanaDatasource struct {
+		Type string `json:"type"`
+		UID  string `json:"uid"`
+	}
+
+	// GrafanaTarget is a PromQL query of a panel.
+	GrafanaTarget struct {
+		RefID        string `json:"refId"`
+		Expr         string `json:"expr"`
+		LegendFormat string `json:"legendFormat,omitempty"`
+	}
+
+	// GrafanaFieldConf holds the field defaults of a panel, such as the unit.
+	GrafanaFieldConf struct {
+		Defaults GrafanaFieldDefaults `json:"defaults"`
+	}
+
+	// GrafanaFieldDefaults are the default field settings of a panel.
+	GrafanaFieldDefaults struct {
+		Unit string `json:"unit,omitempty"`
+	}
+
+	// panelSpec describes a panel before its layout.
+	panelSpec struct {
+		title  string
+		desc   string
+		expr   string
+		legend string
+		unit   string
+	}
+
+	// dashboardBuilder lays out the rows and panels of a dashboard.
+	dashboardBuilder struct {
+		panels []GrafanaPanel
+		y      int
+		used   map[string]bool
+	}
+
+	// useSignal is a system instrument shown in the USE row.
+	useSignal struct {
+		name  string
+		title string
+	}
+)
+
+var (
+	// promNames sanitizes the metric and label names as the Prometheus exposition does.
+	promNames = processor.NameRulesFor(processor.BackendPrometheus)
+
+	// grafanaDatasource is the datasource of every panel, selected by the datasource variable.
+	grafanaDatasource = &GrafanaDatasource{Type: "prometheus", UID: "${datasource}"}
+
+	// useSignals lists the system collector instruments of the USE row, in display order.
+	useSignals = []useSignal{
+		{"process_cpu_seconds_total", "CPU utilization"},
+		{"process_resident_memory_bytes", "Resident memory"},
+		{"go_memstats_heap_alloc_bytes", "Heap in use"},
+		{"go_goroutines", "Goroutines"},
+		{"process_open_fds", "Open file descriptors"},
+		{"cpu_throttling_ratio", "CPU throttling saturation"},
+		{"memory_pressure_level", "Memory pressure"},
+		{"go_gc_advisor_cpu_fraction", "GC CPU saturation"},
+		{"memory_watermark_breaches_total", "Memory watermark breaches"},
+	}
+
+	// grafanaUnits maps the instrument units to the Grafana units.
+	grafanaUnits = map[string]string{
+		"s":  "s",
+		"ms": "ms",
+		"By": "bytes",
+		"1":  "percentunit",
+	}
+)
+
+// GrafanaDashboard builds a Grafana dashboard from the catalog: RED panels, rate,
+// errors and duration, per HTTP route and gRPC method, USE panels for the system
+// collectors, and one panel per other instrument. The queries target the metric
+// names of the Prometheus exposition of this module.
+//
+// Parameters:
+//   - title: The dashboard title
+//   - uid: The dashboard uid, generated by Grafana if empty
+//
+// Returns:
+//   - The dashboard model, ready to be marshaled to JSON
+func (c *Catalog) GrafanaDashboard(title, uid string) *GrafanaDashboard {
+	index := make(map[string]Instrument, len(c.Instruments))
+	for _, inst := range c.Instruments {
+		index[inst.Name] = inst
+	}
+
+	b := &dashboardBuilder{used: map[string]bool{}}
+	b.httpRED(index)
+	b.grpcRED(index)
+	b.systemUSE(index)
+	b.others(c.Instruments)
+
+	return &GrafanaDashboard{
+		Title:         title,
+		UID:           uid,
+		Tags:          []string{"goxkit", "generated"},
+		SchemaVersion: 39,
+		Time:          GrafanaTime{From: "now-6h", To: "now"},
+		Templating: GrafanaTemplate{List: []GrafanaVariable{
+			{Name: "datasource", Label: "Data source", Type: "datasource", Query: "prometheus"},
+		}},
+		Panels: b.panels,
+	}
+}
+
+// WriteGrafanaDashboard generates the catalog and writes its Grafana dashboard as
+// indented JSON to w.
+//
+// Parameters:
+//   - ctx: The context of the collection
+//   - w: The writer receiving the JSON document
+//   - title: The dashboard title
+//   - uid: The dashboard uid, generated by Grafana if empty
+//
+// Returns:
+//   - An error if the metrics cannot be collected or the document cannot be written
+func (g *Generator) WriteGrafanaDashboard(ctx context.Context, w io.Writer, title, uid string) error {
+	c, err := g.Generate(ctx)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.GrafanaDashboard(title, uid))
+}
+
+// httpRED adds the RED row of the HTTP middleware metrics, per route.
+func (b *dashboardBuilder) httpRED(index map[string]Instrument) {
+	requests, ok := index["http.requests"]
+	duration, hasDuration := index["http.request.duration"]
+	if !ok || !hasDuration {
+		return
+	}
+
+	by := groupBy(requests, "uri")
+	counter := promName(requests)
+	b.row("HTTP (RED)", []panelSpec{
+		{title: "Request rate", expr: fmt.Sprintf("sum%s (rate(%s[%s]))", by, counter, rateInterval), legend: legend(by), unit: "reqps"},
+		{title: "Error rate", expr: fmt.Sprintf(`sum%s (rate(%s{statusCode=~"5.."}[%s]))`, by, counter, rateInterval), legend: legend(by), unit: "reqps"},
+		{title: "Duration p95", expr: quantile(duration, groupBy(duration, "uri")), legend: legend(by), unit: grafanaUnit(duration.Unit)},
+	})
+	b.use(requests, duration)
+}
+
+// grpcRED adds the RED row of the gRPC server metrics, per method, with the
+// semantic convention or the Prometheus-classic names.
+func (b *dashboardBuilder) grpcRED(index map[string]Instrument) {
+	handled, duration := index["rpc.server.handled"], index["rpc.server.duration"]
+	method, errFilter := "rpc.method", `rpc_grpc_status_code!="0"`
+	if handled.Name == "" {
+		handled, duration = index["grpc_server_handled_total"], index["grpc_server_handling_seconds"]
+		method, errFilter = "grpc_method", `grpc_code!="OK"`
+	}
+	if handled.Name == "" || duration.Name == "" {
+		return
+	}
+
+	by := groupBy(handled, method)
+	counter := promName(handled)
+	b.row("gRPC (RED)", []panelSpec{
+		{title: "Request rate", expr: fmt.Sprintf("sum%s (rate(%s[%s]))", by, counter, rateInterval), legend: legend(by), unit: "reqps"},
+		{title: "Error rate", expr: fmt.Sprintf("sum%s (rate(%s{%s}[%s]))", by, counter, errFilter, rateInterval), legend: legend(by), unit: "reqps"},
+		{title: "Duration p95", expr: quantile(duration, groupBy(duration, method)), legend: legend(by), unit: grafanaUnit(duration.Unit)},
+	})
+	b.use(handled, duration)
+}
+
+// systemUSE adds the USE row of the system collectors metrics found in the catalog.
+func (b *dashboardBuilder) systemUSE(index map[string]Instrument) {
+	specs := make([]panelSpec, 0, len(useSignals))
+	for _, s := range useSignals {
+		inst, ok := index[s.name]
+		if !ok {
+			continue
+		}
+		spec := instrumentPanel(inst)
+		spec.title = s.title
+		specs = append(specs, spec)
+		b.use(inst)
+	}
+
+	if len(specs) > 0 {
+		b.row("System (USE)", specs)
+	}
+}
+
+// others adds a row with one panel per instrument not shown in the other rows.
+func (b *dashboardBuilder) others(instruments []Instrument) {
+	var specs []panelSpec
+	for _, inst := range instruments {
+		if b.used[inst.Name] || inst.Type == "summary" || inst.Type == "exponential_histogram" {
+			continue
+		}
+		specs = append(specs, instrumentPanel(inst))
+		b.use(inst)
+	}
+
+	if len(specs) > 0 {
+		b.row("Instruments", specs)
+	}
+}
+
+// row adds a row followed by its panels, three per line.
+func (b *dashboardBuilder) row(title string, specs []panelSpec) {
+	b.panels = append(b.panels, GrafanaPanel{
+		ID:      len(b.panels) + 1,
+		Type:    "row",
+		Title:   title,
+		GridPos: GrafanaGridPos{H: 1, W: grafanaGridWidth, Y: b.y},
+	})
+	b.y++
+
+	for i, s := range specs {
+		x := (i * grafanaPanelWidth) % grafanaGridWidth
+		if i > 0 && x == 0 {
+			b.y += grafanaPanelHeight
+		}
+
+		panel := GrafanaPanel{
+			ID:          len(b.panels) + 1,
+			Type:        "timeseries",
+			Title:       s.title,
+			Description: s.desc,
+			GridPos:     GrafanaGridPos{H: grafanaPanelHeight, W: grafanaPanelWidth, X: x, Y: b.y},
+			Datasource:  grafanaDatasource,
+			Targets:     []GrafanaTarget{{RefID: "A", Expr: s.expr, LegendFormat: s.legend}},
+		}
+		if s.unit != "" {
+			panel.FieldConfig = &GrafanaFieldConf{Defaults: GrafanaFieldDefaults{Unit: s.unit}}
+		}
+		b.panels = append(b.panels, panel)
+	}
+	b.y += grafanaPanelHeight
+}
+
+// use marks the instruments as shown.
+func (b *dashboardBuilder) use(instruments ...Instrument) {
+	for _, inst := range instruments {
+		b.used[inst.Name] = true
+	}
+}
+
+// instrumentPanel returns the panel of a single instrument: the rate of a
+// counter, the p95 of a histogram or the value of a gauge.
+func instrumentPanel(inst Instrument) panelSpec {
+	spec := panelSpec{title: inst.Name, desc: inst.Description, unit: grafanaUnit(inst.Unit)}
+
+	switch inst.Type {
+	case "counter":
+		spec.expr = fmt.Sprintf("sum(rate(%s[%s]))", promName(inst), rateInterval)
+		if spec.unit == "" {
+			spec.unit = "ops"
+		}
+	case "histogram":
+		spec.title += " p95"
+		spec.expr = quantile(inst, "")
+	default:
+		spec.expr = fmt.Sprintf("sum(%s)", promName(inst))
+	}
+
+	return spec
+}
+
+// quantile returns the p95 query of a histogram, aggregated by the by clause.
+func quantile(inst Instrument, by string) string {
+	le := " by (le)"
+	if by != "" {
+		le = strings.Replace(by, "(", "(le, ", 1)
+	}
+	return fmt.Sprintf("histogram_quantile(0.95, sum%s (rate(%s_bucket[%s])))", le, promNames.Sanitize(inst.Name), rateInterval)
+}
+
+// groupBy returns the " by (label)" clause of the attribute key, or an empty
+// string if the instrument doesn't record it.
+func groupBy(inst Instrument, key string) string {
+	if !slices.Contains(inst.Attributes, key) {
+		return ""
+	}
+	return fmt.Sprintf(" by (%s)", promNames.Sanitize(key))
+}
+
+// legend returns the legend format of the by clause.
+func legend(by string) string {
+	label := strings.TrimSuffix(strings.TrimPrefix(by, " by ("), ")")
+	if label == "" {
+		return ""
+	}
+	return "{{" + label + "}}"
+}
+
+// promName returns the Prometheus exposition name of an instrument.
+func promName(inst Instrument) string {
+	name := promNames.Sanitize(inst.Name)
+	if inst.Type == "counter" && !strings.HasSuffix(name, "_total") {
+		name += "_total"
+	}
+	return name
+}
+
+// grafanaUnit returns the Grafana unit of an instrument unit, or an empty string if unknown.
+func grafanaUnit(unit string) string {
+	return grafanaUnits[unit]
+}
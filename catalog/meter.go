@@ -0,0 +1,158 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package catalog
+
+import (
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// recordingProvider records in the generator the instruments created by the
+	// meters of the wrapped MeterProvider.
+	recordingProvider struct {
+		metric.MeterProvider
+		g *Generator
+	}
+
+	// recordingMeter records in the generator the instruments it creates.
+	recordingMeter struct {
+		metric.Meter
+		g     *Generator
+		scope string
+	}
+)
+
+// Meter returns the recording meter of the scope.
+func (p *recordingProvider) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	return &recordingMeter{Meter: p.MeterProvider.Meter(name, opts...), g: p.g, scope: name}
+}
+
+// Int64Counter creates and records an int64 counter.
+func (m *recordingMeter) Int64Counter(name string, opts ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	instrument, err := m.Meter.Int64Counter(name, opts...)
+	cfg := metric.NewInt64CounterConfig(opts...)
+	m.record(err, name, "counter", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Int64UpDownCounter creates and records an int64 up-down counter.
+func (m *recordingMeter) Int64UpDownCounter(name string, opts ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	instrument, err := m.Meter.Int64UpDownCounter(name, opts...)
+	cfg := metric.NewInt64UpDownCounterConfig(opts...)
+	m.record(err, name, "updowncounter", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Int64Histogram creates and records an int64 histogram.
+func (m *recordingMeter) Int64Histogram(name string, opts ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	instrument, err := m.Meter.Int64Histogram(name, opts...)
+	cfg := metric.NewInt64HistogramConfig(opts...)
+	m.record(err, name, "histogram", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Int64Gauge creates and records an int64 gauge.
+func (m *recordingMeter) Int64Gauge(name string, opts ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	instrument, err := m.Meter.Int64Gauge(name, opts...)
+	cfg := metric.NewInt64GaugeConfig(opts...)
+	m.record(err, name, "gauge", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Int64ObservableCounter creates and records an int64 observable counter.
+func (m *recordingMeter) Int64ObservableCounter(name string, opts ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	instrument, err := m.Meter.Int64ObservableCounter(name, opts...)
+	cfg := metric.NewInt64ObservableCounterConfig(opts...)
+	m.record(err, name, "counter", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Int64ObservableUpDownCounter creates and records an int64 observable up-down counter.
+func (m *recordingMeter) Int64ObservableUpDownCounter(name string, opts ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	instrument, err := m.Meter.Int64ObservableUpDownCounter(name, opts...)
+	cfg := metric.NewInt64ObservableUpDownCounterConfig(opts...)
+	m.record(err, name, "updowncounter", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Int64ObservableGauge creates and records an int64 observable gauge.
+func (m *recordingMeter) Int64ObservableGauge(name string, opts ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	instrument, err := m.Meter.Int64ObservableGauge(name, opts...)
+	cfg := metric.NewInt64ObservableGaugeConfig(opts...)
+	m.record(err, name, "gauge", "int64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64Counter creates and records a float64 counter.
+func (m *recordingMeter) Float64Counter(name string, opts ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	instrument, err := m.Meter.Float64Counter(name, opts...)
+	cfg := metric.NewFloat64CounterConfig(opts...)
+	m.record(err, name, "counter", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64UpDownCounter creates and records a float64 up-down counter.
+func (m *recordingMeter) Float64UpDownCounter(name string, opts ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
+	instrument, err := m.Meter.Float64UpDownCounter(name, opts...)
+	cfg := metric.NewFloat64UpDownCounterConfig(opts...)
+	m.record(err, name, "updowncounter", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64Histogram creates and records a float64 histogram.
+func (m *recordingMeter) Float64Histogram(name string, opts ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	instrument, err := m.Meter.Float64Histogram(name, opts...)
+	cfg := metric.NewFloat64HistogramConfig(opts...)
+	m.record(err, name, "histogram", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64Gauge creates and records a float64 gauge.
+func (m *recordingMeter) Float64Gauge(name string, opts ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	instrument, err := m.Meter.Float64Gauge(name, opts...)
+	cfg := metric.NewFloat64GaugeConfig(opts...)
+	m.record(err, name, "gauge", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64ObservableCounter creates and records a float64 observable counter.
+func (m *recordingMeter) Float64ObservableCounter(name string, opts ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
+	instrument, err := m.Meter.Float64ObservableCounter(name, opts...)
+	cfg := metric.NewFloat64ObservableCounterConfig(opts...)
+	m.record(err, name, "counter", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64ObservableUpDownCounter creates and records a float64 observable up-down counter.
+func (m *recordingMeter) Float64ObservableUpDownCounter(name string, opts ...metric.Float64ObservableUpDownCounterOption) (metric.Float64ObservableUpDownCounter, error) {
+	instrument, err := m.Meter.Float64ObservableUpDownCounter(name, opts...)
+	cfg := metric.NewFloat64ObservableUpDownCounterConfig(opts...)
+	m.record(err, name, "updowncounter", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// Float64ObservableGauge creates and records a float64 observable gauge.
+func (m *recordingMeter) Float64ObservableGauge(name string, opts ...metric.Float64ObservableGaugeOption) (metric.Float64ObservableGauge, error) {
+	instrument, err := m.Meter.Float64ObservableGauge(name, opts...)
+	cfg := metric.NewFloat64ObservableGaugeConfig(opts...)
+	m.record(err, name, "gauge", "float64", cfg.Unit(), cfg.Description())
+	return instrument, err
+}
+
+// record records the instrument in the generator, unless it failed to be created.
+func (m *recordingMeter) record(err error, name, typ, valueType, unit, description string) {
+	if err != nil {
+		return
+	}
+
+	m.g.record(Instrument{
+		Name:        name,
+		Type:        typ,
+		ValueType:   valueType,
+		Unit:        unit,
+		Description: description,
+		Scope:       m.scope,
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// coldStartOnce ensures the cold start is only recorded once per process.
+var coldStartOnce sync.Once
+
+// RecordColdStart increments the faas.coldstarts counter the first time it is called
+// in the process, it is a no-op afterwards. Serverless handlers call it on every
+// invocation, so only the invocation initializing the runtime is counted.
+// Instrument creation errors are reported to the OpenTelemetry error handler.
+//
+// Parameters:
+//   - ctx: The context of the invocation
+//   - attrs: The attributes of the measurement, such as faas.trigger
+func RecordColdStart(ctx context.Context, attrs ...attribute.KeyValue) {
+	coldStartOnce.Do(func() {
+		counter, err := otel.Meter(instrumentationScope).Int64Counter("faas.coldstarts", metric.WithDescription("Number of invocation cold starts."), metric.WithUnit("{coldstart}"))
+		if err != nil {
+			otel.Handle(err)
+			return
+		}
+
+		counter.Add(ctx, 1, metric.WithAttributes(attrs...))
+	})
+}
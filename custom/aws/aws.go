@@ -0,0 +1,148 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package aws provides client metrics for AWS services and compatible stores, such
+// as S3-compatible object storages. The recorders don't depend on the AWS SDK: the
+// calls are described with plain structs, filled from the SDK input and output
+// types with the reflection helpers of this package.
+//
+// With the AWS SDK for Go v2, a recorder is attached to every call of a client by
+// an initialize middleware registered in the APIOptions:
+//
+//	s3Metrics, _ := aws.NewS3Metrics(nil)
+//
+//	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+//		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("metrics", func(
+//			ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+//		) (middleware.InitializeOutput, middleware.Metadata, error) {
+//			start := time.Now()
+//			out, md, err := next.HandleInitialize(ctx, in)
+//
+//			call := aws.NewS3Call(awsmiddleware.GetOperationName(ctx), in.Parameters, out.Result)
+//			call.Duration = time.Since(start)
+//			if results, ok := retry.GetAttemptResults(md); ok {
+//				call.Attempts = len(results.Results)
+//			}
+//			call.Err = err
+//			s3Metrics.Record(ctx, call)
+//
+//			return out, md, err
+//		}), middleware.After)
+//	})
+package aws
+
+import (
+	"errors"
+	"reflect"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+// instrumentationScope is the instrumentation scope of the default meter.
+const instrumentationScope = "github.com/goxkit/metrics/custom/aws"
+
+// rpcSystemAWS is the rpc.system attribute of the AWS API calls.
+var rpcSystemAWS = semconv.RPCSystemKey.String("aws-api")
+
+// ErrorCode returns the error code of an AWS API error, such as NoSuchKey or
+// ThrottlingException, found in the chain of err with errors.As. It returns the
+// Go type of the error when err carries no code, and an empty string for a nil error.
+//
+// Parameters:
+//   - err: The error returned by the call
+//
+// Returns:
+//   - The error code
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var apiErr interface{ ErrorCode() string }
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() != "" {
+		return apiErr.ErrorCode()
+	}
+	return reflect.TypeOf(err).String()
+}
+
+// meterOrDefault returns meter, or the global meter of the package instrumentation scope if nil.
+func meterOrDefault(meter metric.Meter) metric.Meter {
+	if meter == nil {
+		return otel.Meter(instrumentationScope)
+	}
+	return meter
+}
+
+// stringField returns the value of the *string or string field name of the struct
+// pointed to by v, or an empty string if there is no such field.
+func stringField(v any, name string) string {
+	f, ok := field(v, name)
+	if !ok {
+		return ""
+	}
+
+	switch {
+	case f.Kind() == reflect.String:
+		return f.String()
+	case f.Kind() == reflect.Pointer && !f.IsNil() && f.Elem().Kind() == reflect.String:
+		return f.Elem().String()
+	default:
+		return ""
+	}
+}
+
+// int64Field returns the value of the *int64 or int64 field name of the struct
+// pointed to by v, or zero if there is no such field.
+func int64Field(v any, name string) int64 {
+	f, ok := field(v, name)
+	if !ok {
+		return 0
+	}
+
+	switch {
+	case f.Kind() == reflect.Int64:
+		return f.Int()
+	case f.Kind() == reflect.Pointer && !f.IsNil() && f.Elem().Kind() == reflect.Int64:
+		return f.Elem().Int()
+	default:
+		return 0
+	}
+}
+
+// float64Field returns the value of the *float64 or float64 field name of the struct
+// pointed to by v, or zero if there is no such field.
+func float64Field(v any, name string) float64 {
+	f, ok := field(v, name)
+	if !ok {
+		return 0
+	}
+
+	switch {
+	case f.Kind() == reflect.Float64:
+		return f.Float()
+	case f.Kind() == reflect.Pointer && !f.IsNil() && f.Elem().Kind() == reflect.Float64:
+		return f.Elem().Float()
+	default:
+		return 0
+	}
+}
+
+// field returns the field name of the struct, or pointer to struct, v.
+func field(v any, name string) (reflect.Value, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	f := rv.FieldByName(name)
+	return f, f.IsValid()
+}
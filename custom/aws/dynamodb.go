@@ -0,0 +1,195 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+// dynamoDBThrottlingCodes lists the error codes of the throttled DynamoDB calls.
+var dynamoDBThrottlingCodes = map[string]bool{
+	"ProvisionedThroughputExceededException": true,
+	"ThrottlingException":                    true,
+	"RequestLimitExceeded":                   true,
+}
+
+// dynamoDBReadOperations lists the operations consuming read capacity.
+var dynamoDBReadOperations = map[string]bool{
+	"GetItem":          true,
+	"BatchGetItem":     true,
+	"Query":            true,
+	"Scan":             true,
+	"TransactGetItems": true,
+	"ExecuteStatement": true,
+}
+
+type (
+	// DynamoDBCall describes a call to DynamoDB.
+	DynamoDBCall struct {
+		// Table is the table of the call, empty for the batch and transaction calls.
+		Table string
+		// Operation is the API operation, such as PutItem.
+		Operation string
+		// Duration is the latency of the call, retries included.
+		Duration time.Duration
+		// Capacity is the capacity consumed per table, returned when the request
+		// sets ReturnConsumedCapacity.
+		Capacity []ConsumedCapacity
+		// Err is the error returned by the call, nil on success.
+		Err error
+	}
+
+	// ConsumedCapacity is the capacity consumed by a call on a table. Read and
+	// Write are only returned with ReturnConsumedCapacity set to INDEXES, Total
+	// is attributed to the read or write capacity by operation otherwise.
+	ConsumedCapacity struct {
+		Table string
+		Read  float64
+		Write float64
+		Total float64
+	}
+
+	// DynamoDBMetrics records the latency, outcome, throttling and consumed
+	// capacity of the DynamoDB calls, per table and operation, without the delay
+	// of the CloudWatch metrics. It is safe for concurrent use.
+	DynamoDBMetrics struct {
+		duration  metric.Float64Histogram
+		requests  metric.Int64Counter
+		throttles metric.Int64Counter
+		capacity  metric.Float64Counter
+	}
+)
+
+// NewDynamoDBMetrics creates the DynamoDB client metrics and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//
+// Returns:
+//   - The DynamoDBMetrics recording the calls
+//   - An error if the meter instruments cannot be created
+func NewDynamoDBMetrics(meter metric.Meter) (*DynamoDBMetrics, error) {
+	meter = meterOrDefault(meter)
+
+	duration, err := meter.Float64Histogram("dynamodb.client.duration", metric.WithDescription("Latency of the DynamoDB calls, retries included."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := meter.Int64Counter("dynamodb.client.requests", metric.WithDescription("Number of DynamoDB calls, by error code."), metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	throttles, err := meter.Int64Counter("dynamodb.client.throttles", metric.WithDescription("Number of DynamoDB calls rejected by throttling."), metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	capacity, err := meter.Float64Counter("dynamodb.client.consumed_capacity", metric.WithDescription("Capacity units consumed by the DynamoDB calls, by table and capacity type."), metric.WithUnit("{capacity_unit}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamoDBMetrics{duration: duration, requests: requests, throttles: throttles, capacity: capacity}, nil
+}
+
+// NewDynamoDBCall creates the DynamoDBCall of an operation from the SDK input and
+// output values, reading their TableName and ConsumedCapacity fields. The duration
+// and error are set by the caller.
+//
+// Parameters:
+//   - operation: The API operation
+//   - params: The input of the operation, such as *dynamodb.PutItemInput
+//   - result: The output of the operation, such as *dynamodb.PutItemOutput, nil on failure
+//
+// Returns:
+//   - The DynamoDBCall with the table and consumed capacity set
+func NewDynamoDBCall(operation string, params, result any) DynamoDBCall {
+	call := DynamoDBCall{Table: stringField(params, "TableName"), Operation: operation}
+
+	f, ok := field(result, "ConsumedCapacity")
+	if !ok {
+		return call
+	}
+
+	// A single consumed capacity, or one per table for the batch and transaction calls
+	if f.Kind() == reflect.Slice {
+		for i := 0; i < f.Len(); i++ {
+			call.Capacity = append(call.Capacity, consumedCapacity(f.Index(i).Addr().Interface()))
+		}
+	} else if f.Kind() == reflect.Pointer && !f.IsNil() {
+		call.Capacity = append(call.Capacity, consumedCapacity(f.Interface()))
+	}
+
+	return call
+}
+
+// consumedCapacity converts an SDK types.ConsumedCapacity to a ConsumedCapacity.
+func consumedCapacity(v any) ConsumedCapacity {
+	return ConsumedCapacity{
+		Table: stringField(v, "TableName"),
+		Read:  float64Field(v, "ReadCapacityUnits"),
+		Write: float64Field(v, "WriteCapacityUnits"),
+		Total: float64Field(v, "CapacityUnits"),
+	}
+}
+
+// Record records a call. The error code of a failed call is recorded as the
+// error.type attribute, and the throttled calls are also counted in dynamodb.client.throttles.
+//
+// Parameters:
+//   - ctx: The context of the call
+//   - call: The call description
+func (m *DynamoDBMetrics) Record(ctx context.Context, call DynamoDBCall) {
+	attrs := []attribute.KeyValue{
+		rpcSystemAWS,
+		semconv.RPCService("DynamoDB"),
+		semconv.RPCMethod(call.Operation),
+		semconv.AWSDynamoDBTableNames(call.Table),
+	}
+	opt := metric.WithAttributes(attrs...)
+
+	m.duration.Record(ctx, call.Duration.Seconds(), opt)
+	for _, c := range call.Capacity {
+		m.recordCapacity(ctx, call.Operation, c)
+	}
+
+	if call.Err != nil {
+		code := ErrorCode(call.Err)
+		if dynamoDBThrottlingCodes[code] {
+			m.throttles.Add(ctx, 1, opt)
+		}
+		opt = metric.WithAttributes(append(attrs, semconv.ErrorTypeKey.String(code))...)
+	}
+	m.requests.Add(ctx, 1, opt)
+}
+
+// recordCapacity records the read and write capacity consumed on a table.
+func (m *DynamoDBMetrics) recordCapacity(ctx context.Context, operation string, c ConsumedCapacity) {
+	read, write := c.Read, c.Write
+	if read == 0 && write == 0 {
+		if dynamoDBReadOperations[operation] {
+			read = c.Total
+		} else {
+			write = c.Total
+		}
+	}
+
+	table := semconv.AWSDynamoDBTableNames(c.Table)
+	if read > 0 {
+		m.capacity.Add(ctx, read, metric.WithAttributes(table, attribute.String("capacity.type", "read")))
+	}
+	if write > 0 {
+		m.capacity.Add(ctx, write, metric.WithAttributes(table, attribute.String("capacity.type", "write")))
+	}
+}
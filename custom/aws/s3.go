@@ -0,0 +1,131 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package aws
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+type (
+	// S3Call describes a call to an S3-compatible object storage.
+	S3Call struct {
+		// Bucket is the bucket of the call, empty for the calls without bucket such as ListBuckets.
+		Bucket string
+		// Operation is the API operation, such as GetObject.
+		Operation string
+		// Duration is the latency of the call, retries included.
+		Duration time.Duration
+		// Attempts is the number of attempts, retries are counted from the second one.
+		Attempts int
+		// BytesSent is the size of the uploaded payload.
+		BytesSent int64
+		// BytesReceived is the size of the downloaded payload.
+		BytesReceived int64
+		// Err is the error returned by the call, nil on success.
+		Err error
+	}
+
+	// S3Metrics records the latency, outcome, retries and transferred bytes of the
+	// calls to an S3-compatible object storage, per bucket and operation.
+	// It is safe for concurrent use.
+	S3Metrics struct {
+		duration    metric.Float64Histogram
+		requests    metric.Int64Counter
+		retries     metric.Int64Counter
+		transferred metric.Int64Counter
+	}
+)
+
+// NewS3Metrics creates the S3 client metrics and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//
+// Returns:
+//   - The S3Metrics recording the calls
+//   - An error if the meter instruments cannot be created
+func NewS3Metrics(meter metric.Meter) (*S3Metrics, error) {
+	meter = meterOrDefault(meter)
+
+	duration, err := meter.Float64Histogram("s3.client.duration", metric.WithDescription("Latency of the object storage calls, retries included."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := meter.Int64Counter("s3.client.requests", metric.WithDescription("Number of object storage calls, by error code."), metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	retries, err := meter.Int64Counter("s3.client.retries", metric.WithDescription("Number of object storage call retries."), metric.WithUnit("{retry}"))
+	if err != nil {
+		return nil, err
+	}
+
+	transferred, err := meter.Int64Counter("s3.client.transferred", metric.WithDescription("Bytes transferred to and from the object storage, by direction."), metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Metrics{duration: duration, requests: requests, retries: retries, transferred: transferred}, nil
+}
+
+// NewS3Call creates the S3Call of an operation from the SDK input and output values,
+// reading their Bucket and ContentLength fields. The duration, attempts and error
+// are set by the caller.
+//
+// Parameters:
+//   - operation: The API operation
+//   - params: The input of the operation, such as *s3.PutObjectInput
+//   - result: The output of the operation, such as *s3.GetObjectOutput, nil on failure
+//
+// Returns:
+//   - The S3Call with the bucket and transferred bytes set
+func NewS3Call(operation string, params, result any) S3Call {
+	return S3Call{
+		Bucket:        stringField(params, "Bucket"),
+		Operation:     operation,
+		BytesSent:     int64Field(params, "ContentLength"),
+		BytesReceived: int64Field(result, "ContentLength"),
+	}
+}
+
+// Record records a call. The error code of a failed call is recorded as the
+// error.type attribute.
+//
+// Parameters:
+//   - ctx: The context of the call
+//   - call: The call description
+func (m *S3Metrics) Record(ctx context.Context, call S3Call) {
+	attrs := []attribute.KeyValue{
+		rpcSystemAWS,
+		semconv.RPCService("S3"),
+		semconv.RPCMethod(call.Operation),
+		semconv.AWSS3Bucket(call.Bucket),
+	}
+	opt := metric.WithAttributes(attrs...)
+
+	m.duration.Record(ctx, call.Duration.Seconds(), opt)
+	if call.Attempts > 1 {
+		m.retries.Add(ctx, int64(call.Attempts-1), opt)
+	}
+	if call.BytesSent > 0 {
+		m.transferred.Add(ctx, call.BytesSent, metric.WithAttributes(append(attrs, attribute.String("direction", "sent"))...))
+	}
+	if call.BytesReceived > 0 {
+		m.transferred.Add(ctx, call.BytesReceived, metric.WithAttributes(append(attrs, attribute.String("direction", "received"))...))
+	}
+
+	if call.Err != nil {
+		opt = metric.WithAttributes(append(attrs, semconv.ErrorTypeKey.String(ErrorCode(call.Err)))...)
+	}
+	m.requests.Add(ctx, 1, opt)
+}
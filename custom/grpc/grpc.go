@@ -0,0 +1,159 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package grpc provides gRPC server interceptors for metrics collection. By default
+// they follow the OpenTelemetry RPC semantic conventions, recording the
+// rpc.server.duration histogram and the rpc.server.handled counter by
+// rpc.grpc.status_code.
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/goxkit/metrics/buckets"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Method types reported by the grpc_type attribute of the Prometheus-classic metrics.
+const (
+	Unary        = "unary"
+	ClientStream = "client_stream"
+	ServerStream = "server_stream"
+	BidiStream   = "bidi_stream"
+)
+
+// ServerMetrics records the handling time and the outcome of the gRPC calls
+// served through its interceptors. It is safe for concurrent use.
+type ServerMetrics struct {
+	// handled counts the handled calls by status code.
+	handled metric.Int64Counter
+
+	// duration measures the handling time of the calls, in milliseconds with
+	// the semantic conventions and in seconds with the Prometheus-classic names.
+	duration metric.Float64Histogram
+
+	// cfg holds the settings applied by the options.
+	cfg *serverConfig
+}
+
+// NewServerMetrics creates the gRPC server metrics and their instruments.
+//
+// Parameters:
+//   - opts: Optional settings, such as WithPrometheusNames.
+//
+// Returns:
+//   - The ServerMetrics providing the interceptors.
+//   - An error if the meter instruments cannot be created.
+func NewServerMetrics(opts ...Option) (*ServerMetrics, error) {
+	cfg := &serverConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	// Create a meter with an appropriate instrumentation scope name
+	meter := otel.Meter("github.com/goxkit/metrics/custom/grpc")
+
+	// The buckets of the web-request preset, from 5ms to 10s, in the histogram unit
+	counterName, durationName, unit := "rpc.server.handled", "rpc.server.duration", "ms"
+	boundaries := buckets.WebRequest.In(time.Millisecond)
+	if cfg.prometheusNames {
+		counterName, durationName, unit = "grpc_server_handled_total", "grpc_server_handling_seconds", "s"
+		boundaries = buckets.WebRequest.Boundaries()
+	}
+
+	handled, err := meter.Int64Counter(counterName, metric.WithDescription("Number of gRPC calls handled by the server, by status code."))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram(durationName, metric.WithDescription("Handling time of the gRPC calls by the server."), metric.WithUnit(unit), metric.WithExplicitBucketBoundaries(boundaries...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServerMetrics{handled: handled, duration: duration, cfg: cfg}, nil
+}
+
+// UnaryServerInterceptor returns the interceptor recording the metrics of unary calls.
+//
+// Returns:
+//   - A grpc.UnaryServerInterceptor to register with grpc.ChainUnaryInterceptor
+func (m *ServerMetrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.record(ctx, info.FullMethod, Unary, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor recording the metrics of streaming
+// calls. The handling time covers the whole stream.
+//
+// Returns:
+//   - A grpc.StreamServerInterceptor to register with grpc.ChainStreamInterceptor
+func (m *ServerMetrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.record(ss.Context(), info.FullMethod, streamType(info), start, err)
+		return err
+	}
+}
+
+// record records the handling time and the outcome of a call.
+func (m *ServerMetrics) record(ctx context.Context, fullMethod, methodType string, start time.Time, err error) {
+	elapsed := time.Since(start)
+	service, method := splitMethod(fullMethod)
+	code := status.Code(err)
+
+	if m.cfg.prometheusNames {
+		attrs := []attribute.KeyValue{
+			attribute.String("grpc_type", methodType),
+			attribute.String("grpc_service", service),
+			attribute.String("grpc_method", method),
+		}
+		m.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+		m.handled.Add(ctx, 1, metric.WithAttributes(append(attrs, attribute.String("grpc_code", code.String()))...))
+		return
+	}
+
+	opt := metric.WithAttributes(
+		semconv.RPCSystemGRPC,
+		semconv.RPCService(service),
+		semconv.RPCMethod(method),
+		semconv.RPCGRPCStatusCodeKey.Int(int(code)),
+	)
+	m.duration.Record(ctx, float64(elapsed)/float64(time.Millisecond), opt)
+	m.handled.Add(ctx, 1, opt)
+}
+
+// splitMethod splits a full method name, /package.Service/Method, into its
+// service and method parts.
+func splitMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndexByte(fullMethod, '/'); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// streamType returns the grpc_type of a streaming call.
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return BidiStream
+	case info.IsClientStream:
+		return ClientStream
+	default:
+		return ServerStream
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package grpc
+
+type (
+	// Option configures the gRPC server metrics.
+	Option func(*serverConfig)
+
+	// serverConfig holds the settings of the gRPC server metrics.
+	serverConfig struct {
+		// prometheusNames records the grpc_server_* metrics of go-grpc-prometheus
+		// instead of the OpenTelemetry RPC semantic conventions.
+		prometheusNames bool
+	}
+)
+
+// WithPrometheusNames records the classic go-grpc-prometheus metrics,
+// grpc_server_handled_total and grpc_server_handling_seconds with the grpc_type,
+// grpc_service, grpc_method and grpc_code labels, instead of the OpenTelemetry RPC
+// semantic conventions, for drop-in compatibility with existing dashboards. The
+// handling time histogram keeps the buckets of buckets.WebRequest, from 5ms to 10s,
+// in seconds instead of milliseconds.
+//
+// Returns:
+//   - An Option enabling the Prometheus-classic names
+func WithPrometheusNames() Option {
+	return func(c *serverConfig) {
+		c.prometheusNames = true
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package health provides a dependency health scorecard: the application registers
+// its dependencies, such as databases, caches and brokers, with health check
+// functions that are run periodically, and their up/down status and check latency
+// are exported as gauges.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// DefaultInterval is the default interval between two health checks.
+	DefaultInterval = 30 * time.Second
+	// DefaultTimeout is the default timeout of a health check.
+	DefaultTimeout = 5 * time.Second
+)
+
+type (
+	// CheckFunc checks the health of a dependency, returning an error if it is down.
+	CheckFunc func(ctx context.Context) error
+
+	// Dependency describes a dependency checked by the Scorecard.
+	Dependency struct {
+		// Name identifies the dependency, such as "orders-db".
+		Name string
+		// Type is the kind of dependency, such as "postgres", "redis" or "rabbitmq".
+		Type string
+		// Check is the health check function.
+		Check CheckFunc
+		// Timeout bounds the health check, DefaultTimeout if zero.
+		Timeout time.Duration
+	}
+
+	// Scorecard runs the health checks of the registered dependencies every interval
+	// and reports the dependency.up gauge, 1 when healthy and 0 otherwise, and the
+	// dependency.check.duration gauge, both with the dependency and type attributes.
+	//
+	// A Scorecard is safe for concurrent use.
+	Scorecard struct {
+		interval time.Duration
+
+		up       metric.Int64ObservableGauge
+		duration metric.Float64ObservableGauge
+
+		mu           sync.RWMutex
+		dependencies map[string]*dependencyState
+
+		stop     chan struct{}
+		stopOnce sync.Once
+	}
+
+	// dependencyState holds a dependency and the result of its last check.
+	dependencyState struct {
+		dependency Dependency
+		attrs      metric.MeasurementOption
+
+		checked  bool
+		up       bool
+		duration time.Duration
+	}
+)
+
+// NewScorecard creates a Scorecard with instruments created by the given meter and
+// starts the goroutine running the health checks.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//   - interval: The interval between two health checks, DefaultInterval if not positive
+//
+// Returns:
+//   - A started Scorecard
+//   - An error if the instruments cannot be created
+func NewScorecard(meter metric.Meter, interval time.Duration) (*Scorecard, error) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	up, err := meter.Int64ObservableGauge("dependency.up", metric.WithDescription("Whether the dependency is healthy (1) or not (0)."))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64ObservableGauge("dependency.check.duration", metric.WithDescription("Duration of the last dependency health check."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scorecard{
+		interval:     interval,
+		up:           up,
+		duration:     duration,
+		dependencies: map[string]*dependencyState{},
+		stop:         make(chan struct{}),
+	}
+
+	if _, err := meter.RegisterCallback(s.observe, up, duration); err != nil {
+		return nil, err
+	}
+
+	go s.run()
+
+	return s, nil
+}
+
+// Register registers a dependency, replacing any dependency with the same name.
+// It is checked immediately, then every interval.
+//
+// Parameters:
+//   - d: The dependency to check
+func (s *Scorecard) Register(d Dependency) {
+	if d.Timeout <= 0 {
+		d.Timeout = DefaultTimeout
+	}
+
+	state := &dependencyState{
+		dependency: d,
+		attrs: metric.WithAttributes(
+			attribute.String("dependency", d.Name),
+			attribute.String("type", d.Type),
+		),
+	}
+
+	s.mu.Lock()
+	s.dependencies[d.Name] = state
+	s.mu.Unlock()
+
+	go s.check(state)
+}
+
+// Unregister stops checking and reporting the named dependency.
+//
+// Parameters:
+//   - name: The dependency name
+func (s *Scorecard) Unregister(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.dependencies, name)
+}
+
+// Stop stops running the health checks.
+func (s *Scorecard) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+}
+
+// run checks all the dependencies every interval until the scorecard is stopped.
+func (s *Scorecard) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.mu.RLock()
+			states := make([]*dependencyState, 0, len(s.dependencies))
+			for _, state := range s.dependencies {
+				states = append(states, state)
+			}
+			s.mu.RUnlock()
+
+			// Checks run concurrently so a slow dependency doesn't delay the others
+			var wg sync.WaitGroup
+			for _, state := range states {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					s.check(state)
+				}()
+			}
+			wg.Wait()
+		}
+	}
+}
+
+// check runs the health check of the dependency and stores its result.
+func (s *Scorecard) check(state *dependencyState) {
+	ctx, cancel := context.WithTimeout(context.Background(), state.dependency.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := state.dependency.Check(ctx)
+	elapsed := time.Since(start)
+
+	s.mu.Lock()
+	state.checked = true
+	state.up = err == nil
+	state.duration = elapsed
+	s.mu.Unlock()
+}
+
+// observe reports the result of the last check of every checked dependency.
+func (s *Scorecard) observe(_ context.Context, observer metric.Observer) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, state := range s.dependencies {
+		if !state.checked {
+			continue
+		}
+
+		var up int64
+		if state.up {
+			up = 1
+		}
+		observer.ObserveInt64(s.up, up, state.attrs)
+		observer.ObserveFloat64(s.duration, state.duration.Seconds(), state.attrs)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/internal/window"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Apdex zones, reported as the apdex.zone attribute.
+const (
+	// ApdexSatisfied is a request served within the satisfied threshold.
+	ApdexSatisfied = "satisfied"
+	// ApdexTolerating is a request served within the tolerating threshold.
+	ApdexTolerating = "tolerating"
+	// ApdexFrustrated is a slower or failed (5xx) request.
+	ApdexFrustrated = "frustrated"
+)
+
+type (
+	// ApdexThresholds are the response time thresholds of the Apdex zones.
+	ApdexThresholds struct {
+		// Satisfied is the target response time T.
+		Satisfied time.Duration
+		// Tolerating is the tolerated response time, 4T if zero.
+		Tolerating time.Duration
+	}
+
+	// apdex computes the Apdex score of the routes.
+	apdex struct {
+		thresholds ApdexThresholds
+		overrides  map[string]ApdexThresholds
+
+		samples metric.Int64Counter
+		// unregister removes the score window from the exporting reader.
+		unregister func()
+
+		mu     sync.Mutex
+		routes map[string]*apdexWindow
+	}
+
+	// apdexWindow counts the samples of a route since the previous export.
+	apdexWindow struct {
+		satisfied, tolerating, total int64
+	}
+)
+
+// WithApdex computes the Apdex score of every route, a single user experience number
+// without backend math. The http.apdex.samples counter records the requests by route
+// and apdex.zone, and the http.apdex.score gauge reports, per route, the score of the
+// requests served since the previous export: (satisfied + tolerating / 2) / total.
+// Failed requests (5xx) are frustrated. Routes are better bounded with WithRoutes or
+// WithUnmatchedRoute.
+//
+// The http.apdex.score gauge is produced by the window producer of the exporting
+// reader, in the github.com/goxkit/metrics/custom/http scope, so only the exports
+// reset the score window: the other readers, such as the Prometheus handler, don't
+// see the gauge and derive the score from the http.apdex.samples rates by apdex.zone.
+// A single middleware of the process can compute the Apdex score, the creation of a
+// second one fails.
+//
+// Parameters:
+//   - thresholds: The default thresholds
+//   - overrides: The thresholds of specific routes, keyed by uri attribute, may be nil
+//
+// Returns:
+//   - An Option enabling the Apdex metrics
+func WithApdex(thresholds ApdexThresholds, overrides map[string]ApdexThresholds) Option {
+	return func(c *middlewareConfig) {
+		c.apdex = &apdex{
+			thresholds: thresholds.withDefaults(),
+			overrides:  make(map[string]ApdexThresholds, len(overrides)),
+			routes:     map[string]*apdexWindow{},
+		}
+		for route, t := range overrides {
+			c.apdex.overrides[route] = t.withDefaults()
+		}
+	}
+}
+
+// withDefaults returns the thresholds with the tolerating threshold defaulting to 4T.
+func (t ApdexThresholds) withDefaults() ApdexThresholds {
+	if t.Tolerating <= 0 {
+		t.Tolerating = 4 * t.Satisfied
+	}
+	return t
+}
+
+// zone returns the Apdex zone of a request.
+func (t ApdexThresholds) zone(elapsed time.Duration, statusCode int) string {
+	switch {
+	case statusCode >= http.StatusInternalServerError:
+		return ApdexFrustrated
+	case elapsed <= t.Satisfied:
+		return ApdexSatisfied
+	case elapsed <= t.Tolerating:
+		return ApdexTolerating
+	default:
+		return ApdexFrustrated
+	}
+}
+
+// register creates the Apdex samples counter and registers the score window.
+func (a *apdex) register(meter metric.Meter) error {
+	samples, err := meter.Int64Counter("http.apdex.samples", metric.WithDescription("Requests by route and Apdex zone."), metric.WithUnit("{request}"))
+	if err != nil {
+		return err
+	}
+	a.samples = samples
+
+	a.unregister, err = window.Default.Register("github.com/goxkit/metrics/custom/http", []string{"http.apdex.score"}, a.collect)
+	return err
+}
+
+// collect returns the score of the routes over the window, then resets the window.
+func (a *apdex) collect(start, now time.Time) []metricdata.Metrics {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var dps []metricdata.DataPoint[float64]
+	for route, w := range a.routes {
+		if w.total == 0 {
+			continue
+		}
+		dps = append(dps, metricdata.DataPoint[float64]{
+			Attributes: attribute.NewSet(attribute.String("uri", route)),
+			StartTime:  start,
+			Time:       now,
+			Value:      (float64(w.satisfied) + float64(w.tolerating)/2) / float64(w.total),
+		})
+		*w = apdexWindow{}
+	}
+	if len(dps) == 0 {
+		return nil
+	}
+
+	return []metricdata.Metrics{window.Gauge("http.apdex.score", "Apdex score of the route since the previous export.", "1", dps)}
+}
+
+// record records the Apdex zone of a request served by route.
+func (a *apdex) record(ctx context.Context, route string, elapsed time.Duration, statusCode int) {
+	thresholds, ok := a.overrides[route]
+	if !ok {
+		thresholds = a.thresholds
+	}
+	zone := thresholds.zone(elapsed, statusCode)
+
+	a.mu.Lock()
+	w, ok := a.routes[route]
+	if !ok {
+		w = &apdexWindow{}
+		a.routes[route] = w
+	}
+	w.total++
+	switch zone {
+	case ApdexSatisfied:
+		w.satisfied++
+	case ApdexTolerating:
+		w.tolerating++
+	}
+	a.mu.Unlock()
+
+	a.samples.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("uri", route),
+		attribute.String("apdex.zone", zone),
+	))
+}
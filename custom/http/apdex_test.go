@@ -0,0 +1,71 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goxkit/metrics/internal/window"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// apdexScores collects reader and returns the Apdex scores by route.
+func apdexScores(t *testing.T, reader sdkmetric.Reader) map[string]float64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	scores := map[string]float64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if gauge, ok := m.Data.(metricdata.Gauge[float64]); ok && m.Name == "http.apdex.score" {
+				for _, dp := range gauge.DataPoints {
+					uri, _ := dp.Attributes.Value("uri")
+					scores[uri.AsString()] = dp.Value
+				}
+			}
+		}
+	}
+	return scores
+}
+
+// TestApdexTwoReaders verifies that only the exporting reader collects and resets the
+// Apdex score window.
+func TestApdexTwoReaders(t *testing.T) {
+	exporting := sdkmetric.NewManualReader(sdkmetric.WithProducer(window.Default))
+	other := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporting), sdkmetric.WithReader(other))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	cfg := &middlewareConfig{}
+	WithApdex(ApdexThresholds{Satisfied: 100 * time.Millisecond}, nil)(cfg)
+	if err := cfg.apdex.register(provider.Meter("test")); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	defer cfg.apdex.unregister()
+
+	ctx := context.Background()
+	cfg.apdex.record(ctx, "GET /orders", 50*time.Millisecond, http.StatusOK)
+	cfg.apdex.record(ctx, "GET /orders", 200*time.Millisecond, http.StatusOK)
+	cfg.apdex.record(ctx, "GET /orders", 50*time.Millisecond, http.StatusInternalServerError)
+	cfg.apdex.record(ctx, "GET /orders", time.Second, http.StatusOK)
+
+	if scores := apdexScores(t, other); len(scores) != 0 {
+		t.Fatalf("other reader scores = %v, want none", scores)
+	}
+	if got := apdexScores(t, exporting)["GET /orders"]; got != 0.375 {
+		t.Fatalf("exported score = %v, want 0.375", got)
+	}
+	if scores := apdexScores(t, exporting); len(scores) != 0 {
+		t.Fatalf("scores after the reset = %v, want none", scores)
+	}
+}
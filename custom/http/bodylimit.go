@@ -0,0 +1,81 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// limitedBody is a request body limited by http.MaxBytesReader, remembering whether
+// the handler read past the limit.
+type limitedBody struct {
+	io.ReadCloser
+	exceeded bool
+}
+
+// WithBodyLimit limits the request bodies to limit bytes with http.MaxBytesReader and
+// counts the requests whose handler read past the limit in the
+// http.request.body.limit_exceeded counter, with the method and uri attributes. Oversized
+// payloads are then distinguishable from the other client errors, which share the same
+// 4xx status codes.
+//
+// Parameters:
+//   - limit: The maximum body size in bytes, no limit if not positive
+//
+// Returns:
+//   - An Option enabling the body limit
+func WithBodyLimit(limit int64) Option {
+	return func(c *middlewareConfig) {
+		c.bodyLimit = limit
+	}
+}
+
+// newBodyLimitCounter creates the body limit exceeded counter.
+func newBodyLimitCounter(meter metric.Meter) (metric.Int64Counter, error) {
+	return meter.Int64Counter("http.request.body.limit_exceeded",
+		metric.WithDescription("Requests whose body exceeded the size limit."),
+		metric.WithUnit("{request}"),
+	)
+}
+
+// Read reads from the limited body, flagging the limit errors.
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		b.exceeded = true
+	}
+	return n, err
+}
+
+// limitBody replaces the request body by a limited body, returned to be checked once served.
+func (m *httpMetricsMiddleware) limitBody(w http.ResponseWriter, r *http.Request) *limitedBody {
+	if m.cfg.bodyLimit <= 0 || r.Body == nil {
+		return nil
+	}
+
+	body := &limitedBody{ReadCloser: http.MaxBytesReader(w, r.Body, m.cfg.bodyLimit)}
+	r.Body = body
+	return body
+}
+
+// recordBodyLimit records the request if its handler read past the body limit.
+func (m *httpMetricsMiddleware) recordBodyLimit(ctx context.Context, r *http.Request, uri string, body *limitedBody) {
+	if body == nil || !body.exceeded {
+		return
+	}
+
+	m.bodyLimitExceeded.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("method", r.Method),
+		attribute.String("uri", uri),
+	))
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/goxkit/configs"
+	"go.uber.org/zap"
+)
+
+type (
+	// Middleware wraps an http.Handler.
+	Middleware func(next http.Handler) http.Handler
+
+	// RequestInfo holds the route and status of a request served by a Chain, shared
+	// by the tracing, logging and metrics middlewares. It is filled once the
+	// application handler returns.
+	RequestInfo struct {
+		// Route is the matched route pattern, such as "GET /orders/{id}".
+		Route string
+		// StatusCode is the response status code.
+		StatusCode int
+	}
+
+	// ChainOption configures the middleware chain.
+	ChainOption func(*chainConfig)
+
+	// chainConfig holds the settings of the middleware chain.
+	chainConfig struct {
+		tracing        Middleware
+		logging        Middleware
+		metricsOptions []Option
+		route          func(r *http.Request) string
+	}
+
+	// requestInfoKey is the context key of the RequestInfo.
+	requestInfoKey struct{}
+)
+
+// WithTracing sets the tracing middleware of the chain, for instance the goxkit
+// tracing middleware or otelhttp.NewMiddleware.
+//
+// Parameters:
+//   - mw: The tracing middleware
+//
+// Returns:
+//   - A ChainOption setting the tracing middleware
+func WithTracing(mw Middleware) ChainOption {
+	return func(c *chainConfig) {
+		c.tracing = mw
+	}
+}
+
+// WithLogging sets the logging middleware of the chain, replacing the default access
+// log written with the configured logger.
+//
+// Parameters:
+//   - mw: The logging middleware
+//
+// Returns:
+//   - A ChainOption setting the logging middleware
+func WithLogging(mw Middleware) ChainOption {
+	return func(c *chainConfig) {
+		c.logging = mw
+	}
+}
+
+// WithMetricsOptions sets the options of the metrics middleware of the chain.
+//
+// Parameters:
+//   - opts: The metrics middleware options
+//
+// Returns:
+//   - A ChainOption setting the metrics middleware options
+func WithMetricsOptions(opts ...Option) ChainOption {
+	return func(c *chainConfig) {
+		c.metricsOptions = append(c.metricsOptions, opts...)
+	}
+}
+
+// WithRouteExtractor sets the function extracting the route of a request once the
+// application handler returned. The default uses the http.ServeMux pattern.
+//
+// Parameters:
+//   - fn: The function returning the route of the request
+//
+// Returns:
+//   - A ChainOption setting the route extractor
+func WithRouteExtractor(fn func(r *http.Request) string) ChainOption {
+	return func(c *chainConfig) {
+		c.route = fn
+	}
+}
+
+// Chain returns a single middleware composing, from the outermost, the tracing, the
+// logging and the metrics middlewares, so logs and metrics are recorded within the
+// request span. The route and status are extracted once and shared through the
+// RequestInfo of the request context; the metrics middleware records the route,
+// instead of the raw request URI, as the uri attribute.
+//
+// Without WithLogging, an access log is written with the configured logger.
+//
+// Parameters:
+//   - cfgs: Application configuration providing the logger
+//   - opts: The middlewares and settings of the chain
+//
+// Returns:
+//   - The composed middleware
+//   - An error if the metrics middleware cannot be created
+func Chain(cfgs *configs.Configs, opts ...ChainOption) (Middleware, error) {
+	c := &chainConfig{route: func(r *http.Request) string { return r.Pattern }}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.logging == nil && cfgs != nil && cfgs.Logger != nil {
+		c.logging = accessLog(cfgs.Logger)
+	}
+
+	metricsMiddleware, err := NewHTTPMetricsMiddleware(c.metricsOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next http.Handler) http.Handler {
+		h := c.capture(next)
+		h = metricsMiddleware.Handler(h)
+		if c.logging != nil {
+			h = c.logging(h)
+		}
+		if c.tracing != nil {
+			h = c.tracing(h)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), requestInfoKey{}, &RequestInfo{StatusCode: http.StatusOK})
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}, nil
+}
+
+// RequestInfoFromContext returns the RequestInfo of a request served by a Chain.
+//
+// Parameters:
+//   - ctx: The request context
+//
+// Returns:
+//   - The RequestInfo, or nil outside of a Chain
+func RequestInfoFromContext(ctx context.Context) *RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(*RequestInfo)
+	return info
+}
+
+// capture wraps the application handler, filling the RequestInfo once it returns.
+func (c *chainConfig) capture(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &responseWriter{w, http.StatusOK}
+		next.ServeHTTP(rw, r)
+
+		if info := RequestInfoFromContext(r.Context()); info != nil {
+			info.Route = c.route(r)
+			info.StatusCode = rw.statusCode
+		}
+	})
+}
+
+// accessLog returns a middleware logging every request with its route, status and duration.
+func accessLog(logger *zap.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			next.ServeHTTP(w, r)
+
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.Duration("duration", time.Since(start)),
+			}
+			if info := RequestInfoFromContext(r.Context()); info != nil {
+				fields = append(fields, zap.String("route", info.Route), zap.Int("statusCode", info.StatusCode))
+			}
+
+			logger.Info("http request", fields...)
+		})
+	}
+}
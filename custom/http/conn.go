@@ -0,0 +1,130 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// ConnMetrics tracks whether the server requests arrive on new or reused keep-alive
+	// connections, helping tune the keep-alive and load balancer settings:
+	//   - http.server.connection.requests: counter of the requests, by connection.reused,
+	//     whose rates give the reuse ratio over any window
+	//   - http.server.connections.opened and http.server.connections.closed: the connection churn
+	//
+	// It is wired to the http.Server ConnState and ConnContext hooks and wraps the server
+	// handler. A ConnMetrics is safe for concurrent use.
+	ConnMetrics struct {
+		requests metric.Int64Counter
+		opened   metric.Int64Counter
+		closed   metric.Int64Counter
+
+		newConnOpt, reusedConnOpt metric.MeasurementOption
+	}
+
+	// connKey is the context key of the connInfo of a connection.
+	connKey struct{}
+
+	// connInfo counts the requests served on a connection.
+	connInfo struct {
+		requests atomic.Int64
+	}
+)
+
+// NewConnMetrics creates the connection reuse metrics and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//
+// Returns:
+//   - The ConnMetrics
+//   - An error if the meter instruments cannot be created
+func NewConnMetrics(meter metric.Meter) (*ConnMetrics, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/http")
+	}
+
+	requests, err := meter.Int64Counter("http.server.connection.requests", metric.WithDescription("Server requests, by whether their connection was reused."), metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	opened, err := meter.Int64Counter("http.server.connections.opened", metric.WithDescription("Server connections opened."), metric.WithUnit("{connection}"))
+	if err != nil {
+		return nil, err
+	}
+
+	closed, err := meter.Int64Counter("http.server.connections.closed", metric.WithDescription("Server connections closed or hijacked."), metric.WithUnit("{connection}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConnMetrics{
+		requests:      requests,
+		opened:        opened,
+		closed:        closed,
+		newConnOpt:    metric.WithAttributes(attribute.Bool("connection.reused", false)),
+		reusedConnOpt: metric.WithAttributes(attribute.Bool("connection.reused", true)),
+	}, nil
+}
+
+// ConnState counts the opened and closed connections, to be set as the http.Server
+// ConnState hook.
+//
+// Parameters:
+//   - conn: The connection
+//   - state: The new state of the connection
+func (c *ConnMetrics) ConnState(_ net.Conn, state http.ConnState) {
+	switch state {
+	case http.StateNew:
+		c.opened.Add(context.Background(), 1)
+	case http.StateClosed, http.StateHijacked:
+		c.closed.Add(context.Background(), 1)
+	}
+}
+
+// ConnContext attaches the request count of the connection to its context, to be set
+// as the http.Server ConnContext hook.
+//
+// Parameters:
+//   - ctx: The base context of the connection
+//   - conn: The connection
+//
+// Returns:
+//   - The context of the connection
+func (c *ConnMetrics) ConnContext(ctx context.Context, _ net.Conn) context.Context {
+	return context.WithValue(ctx, connKey{}, &connInfo{})
+}
+
+// Handler wraps the server handler, recording whether each request is the first one of
+// its connection. Requests of connections without the ConnContext hook are not recorded.
+//
+// Parameters:
+//   - next: The server handler
+//
+// Returns:
+//   - The wrapped handler
+func (c *ConnMetrics) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if info, ok := r.Context().Value(connKey{}).(*connInfo); ok {
+			opt := c.newConnOpt
+			if info.requests.Add(1) > 1 {
+				opt = c.reusedConnOpt
+			}
+			c.requests.Add(r.Context(), 1, opt)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// DeadlineSourceContext is the deadline.source attribute of the budgets read from the request context.
+	DeadlineSourceContext = "context"
+	// DeadlineSourceHeader is the deadline.source attribute of the budgets read from the request header.
+	DeadlineSourceHeader = "header"
+)
+
+// WithDeadlineBudget records the deadline budget remaining when the requests arrive in
+// the http.request.deadline.remaining histogram, so exhausted upstream timeout budgets
+// are detected before the work starts. The budget is read from the request context
+// deadline and from the header, if set, keeping the shortest. Requests without deadline
+// are not recorded, and exhausted budgets are recorded as zero.
+//
+// Parameters:
+//   - header: The header holding the timeout set by the caller, such as
+//     "X-Request-Timeout", as a Go duration ("250ms") or a number of milliseconds.
+//     Only the context deadline is read if empty.
+//
+// Returns:
+//   - An Option enabling the deadline budget histogram
+func WithDeadlineBudget(header string) Option {
+	return func(c *middlewareConfig) {
+		c.deadlineBudget = true
+		c.deadlineHeader = header
+	}
+}
+
+// newDeadlineHistogram creates the remaining deadline budget histogram.
+func newDeadlineHistogram(meter metric.Meter) (metric.Float64Histogram, error) {
+	return meter.Float64Histogram("http.request.deadline.remaining",
+		metric.WithDescription("Deadline budget remaining when the request arrives."),
+		metric.WithUnit("s"),
+	)
+}
+
+// remainingBudget returns the deadline budget of the request at arrival and its source.
+func (c *middlewareConfig) remainingBudget(r *http.Request, arrival time.Time) (time.Duration, string, bool) {
+	remaining, source, ok := time.Duration(0), "", false
+	if deadline, has := r.Context().Deadline(); has {
+		remaining, source, ok = deadline.Sub(arrival), DeadlineSourceContext, true
+	}
+
+	if c.deadlineHeader != "" {
+		if timeout, has := parseTimeout(r.Header.Get(c.deadlineHeader)); has && (!ok || timeout < remaining) {
+			remaining, source, ok = timeout, DeadlineSourceHeader, true
+		}
+	}
+
+	return max(remaining, 0), source, ok
+}
+
+// recordDeadline records the deadline budget remaining at arrival, if any.
+func (m *httpMetricsMiddleware) recordDeadline(ctx context.Context, r *http.Request, uri string, arrival time.Time) {
+	remaining, source, ok := m.cfg.remainingBudget(r, arrival)
+	if !ok {
+		return
+	}
+
+	m.deadlineRemaining.Record(ctx, remaining.Seconds(), metric.WithAttributes(
+		attribute.String("method", r.Method),
+		attribute.String("uri", uri),
+		attribute.String("deadline.source", source),
+	))
+}
+
+// parseTimeout parses a timeout header value, a Go duration or a number of milliseconds.
+func parseTimeout(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(ms) * time.Millisecond, true
+	}
+
+	d, err := time.ParseDuration(value)
+	return d, err == nil
+}
@@ -9,11 +9,14 @@ package http
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type (
@@ -41,6 +44,25 @@ type (
 		// requestDuration measures the duration of HTTP requests.
 		// It provides insights into latency and performance characteristics.
 		requestDuration metric.Float64Histogram
+
+		// deadlineRemaining measures the deadline budget remaining when requests arrive,
+		// created when enabled by WithDeadlineBudget.
+		deadlineRemaining metric.Float64Histogram
+
+		// queueingDelay measures the time spent queued at the load balancer, created when
+		// enabled by WithQueueingDelay.
+		queueingDelay metric.Float64Histogram
+
+		// securityEvents counts the security-relevant rejections, created when enabled
+		// by WithSecurityMetrics.
+		securityEvents metric.Int64Counter
+
+		// bodyLimitExceeded counts the requests whose body exceeded the limit, created
+		// when enabled by WithBodyLimit.
+		bodyLimitExceeded metric.Int64Counter
+
+		// cfg holds the settings applied by the options.
+		cfg *middlewareConfig
 	}
 
 	// responseWriter wraps an http.ResponseWriter to capture the status code.
@@ -56,10 +78,13 @@ type (
 // request counts and durations for HTTP requests. It sets up OpenTelemetry
 // instruments for tracking request metrics with standardized names and descriptions.
 //
+// Parameters:
+//   - opts: Optional settings of the middleware, such as the mesh mode.
+//
 // Returns:
 //   - An HTTPMetricsMiddleware interface for HTTP metrics collection.
 //   - An error if the meter instruments cannot be created.
-func NewHTTPMetricsMiddleware() (HTTPMetricsMiddleware, error) {
+func NewHTTPMetricsMiddleware(opts ...Option) (HTTPMetricsMiddleware, error) {
 	// Create a meter with an appropriate instrumentation scope name
 	meter := otel.Meter("github.com/goxkit/metrics/custom/http")
 
@@ -69,17 +94,68 @@ func NewHTTPMetricsMiddleware() (HTTPMetricsMiddleware, error) {
 		return nil, err
 	}
 
-	// Create a histogram for measuring HTTP request durations
-	duration, err := meter.Float64Histogram("http.request.duration", metric.WithDescription("HTTP Request Duration"))
+	cfg := newMiddlewareConfig(opts...)
+
+	// Create a histogram for measuring HTTP request durations, recorded in nanoseconds
+	durationOpts := []metric.Float64HistogramOption{metric.WithDescription("HTTP Request Duration")}
+	if boundaries := cfg.bucketPreset.In(time.Nanosecond); len(boundaries) > 0 {
+		durationOpts = append(durationOpts, metric.WithExplicitBucketBoundaries(boundaries...))
+	}
+
+	duration, err := meter.Float64Histogram("http.request.duration", durationOpts...)
 	if err != nil {
 		return nil, err
 	}
 
+	// Create the remaining deadline budget histogram when enabled
+	var deadlineRemaining metric.Float64Histogram
+	if cfg.deadlineBudget {
+		if deadlineRemaining, err = newDeadlineHistogram(meter); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the queueing delay histogram when enabled
+	var queueingDelay metric.Float64Histogram
+	if len(cfg.queueStartHeaders) > 0 {
+		if queueingDelay, err = newQueueingHistogram(meter); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the security events counter when enabled
+	var securityEvents metric.Int64Counter
+	if cfg.securityMetrics {
+		if securityEvents, err = newSecurityCounter(meter); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the body limit exceeded counter when enabled
+	var bodyLimitExceeded metric.Int64Counter
+	if cfg.bodyLimit > 0 {
+		if bodyLimitExceeded, err = newBodyLimitCounter(meter); err != nil {
+			return nil, err
+		}
+	}
+
+	// Register the Apdex instruments when enabled
+	if cfg.apdex != nil {
+		if err := cfg.apdex.register(meter); err != nil {
+			return nil, err
+		}
+	}
+
 	// Return the configured middleware implementation
 	return &httpMetricsMiddleware{
-		meter:           meter,
-		requestCounter:  counter,
-		requestDuration: duration,
+		meter:             meter,
+		requestCounter:    counter,
+		requestDuration:   duration,
+		deadlineRemaining: deadlineRemaining,
+		queueingDelay:     queueingDelay,
+		securityEvents:    securityEvents,
+		bodyLimitExceeded: bodyLimitExceeded,
+		cfg:               cfg,
 	}, nil
 }
 
@@ -94,6 +170,12 @@ func NewHTTPMetricsMiddleware() (HTTPMetricsMiddleware, error) {
 // Returns:
 //   - An HTTP handler that collects metrics before calling the wrapped handler.
 func (m *httpMetricsMiddleware) Handler(next http.Handler) http.Handler {
+	return m.handler(next, "")
+}
+
+// handler wraps next with metrics collection, adding the operation attribute when
+// operation is not empty.
+func (m *httpMetricsMiddleware) handler(next http.Handler, operation string) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		// Preserve the request context
 		ctx := r.Context()
@@ -104,35 +186,116 @@ func (m *httpMetricsMiddleware) Handler(next http.Handler) http.Handler {
 		// Record the start time for duration calculation
 		start := time.Now()
 
-		// Process the request with the wrapped handler
-		next.ServeHTTP(rw, r.WithContext(ctx))
+		// Process the request with the wrapped handler, its body limited when enabled
+		req := r.WithContext(ctx)
+		body := m.limitBody(rw, req)
+		next.ServeHTTP(rw, req)
+
+		// Skip or annotate the requests already reported by the service mesh
+		meshed := m.cfg.meshMode != MeshModeDisabled && fromEnvoy(r)
+		if meshed && m.cfg.meshMode == MeshModeSuppress {
+			return
+		}
+
+		// Prefer the route over the raw request URI, the ServeMux sets the pattern
+		// on the request it was given
+		uri := m.cfg.route(req)
+
+		if m.cfg.deadlineBudget {
+			m.recordDeadline(ctx, r, uri, start)
+		}
+		if len(m.cfg.queueStartHeaders) > 0 {
+			m.recordQueueing(ctx, r, uri, start)
+		}
+		if m.cfg.securityMetrics {
+			m.recordSecurity(ctx, r, uri, rw.statusCode)
+		}
+		if m.cfg.bodyLimit > 0 {
+			m.recordBodyLimit(ctx, r, uri, body)
+		}
+		if m.cfg.apdex != nil {
+			m.cfg.apdex.record(ctx, uri, time.Since(start), rw.statusCode)
+		}
+
+		// Use the attributes built at startup for the pre-registered routes
+		if operation == "" && !meshed && !m.cfg.traceCorrelation {
+			if opt := m.cfg.lookup(r.Method, uri, rw.statusCode); opt != nil {
+				m.requestDuration.Record(ctx, float64(time.Since(start).Nanoseconds()), opt)
+				m.requestCounter.Add(ctx, 1, opt)
+				return
+			}
+		}
+
+		attrs := []attribute.KeyValue{
+			attribute.String("method", r.Method),
+			attribute.String("uri", uri),
+			attribute.Int("statusCode", rw.statusCode),
+		}
+		if operation != "" {
+			attrs = append(attrs, attribute.String("operation", operation))
+		}
+		if meshed {
+			attrs = append(attrs, attribute.Bool("mesh", true))
+		}
+		if m.cfg.traceCorrelation {
+			attrs = append(attrs,
+				semconv.ServiceInstanceID(m.cfg.instanceID),
+				attribute.Bool("trace.sampled", trace.SpanContextFromContext(ctx).IsSampled()),
+			)
+		}
 
 		// Record the request duration with method, URI, and status attributes
 		m.requestDuration.Record(
 			ctx,
 			float64(time.Since(start).Nanoseconds()),
-			metric.WithAttributes(
-				attribute.String("method", r.Method),
-				attribute.String("uri", r.RequestURI),
-				attribute.Int("statusCode", rw.statusCode),
-			),
+			metric.WithAttributes(attrs...),
 		)
 
 		// Increment the request counter with the same attributes
 		m.requestCounter.Add(
 			ctx,
 			1,
-			metric.WithAttributes(
-				attribute.String("method", r.Method),
-				attribute.String("uri", r.RequestURI),
-				attribute.Int("statusCode", rw.statusCode),
-			),
+			metric.WithAttributes(attrs...),
 		)
 	}
 
 	return http.HandlerFunc(fn)
 }
 
+// WrapHandlerFunc wraps a single handler with metrics collection, recording the
+// requests with an explicit operation attribute. It suits applications registering
+// their handlers individually and wanting named operations without route inference.
+// Instrument creation errors are reported to the OpenTelemetry error handler and h
+// is returned unwrapped.
+//
+// Parameters:
+//   - name: The operation name, reported as the operation attribute.
+//   - h: The handler to wrap.
+//   - opts: Optional settings of the middleware, such as the mesh mode.
+//
+// Returns:
+//   - A handler that collects metrics before calling h.
+func WrapHandlerFunc(name string, h http.HandlerFunc, opts ...Option) http.HandlerFunc {
+	m, err := NewHTTPMetricsMiddleware(opts...)
+	if err != nil {
+		otel.Handle(err)
+		return h
+	}
+
+	return m.(*httpMetricsMiddleware).handler(h, name).ServeHTTP
+}
+
+// fromEnvoy reports whether the request was proxied by an Envoy sidecar, which
+// always adds x-envoy-* headers to the requests it forwards.
+func fromEnvoy(r *http.Request) bool {
+	for name := range r.Header {
+		if strings.HasPrefix(strings.ToLower(name), "x-envoy-") {
+			return true
+		}
+	}
+	return false
+}
+
 // WriteHeader captures the status code and delegates to the wrapped ResponseWriter.
 // This method intercepts the status code being written to the HTTP response so that
 // it can be included in metrics, while maintaining the original functionality.
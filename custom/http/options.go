@@ -0,0 +1,164 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/goxkit/metrics/buckets"
+)
+
+const (
+	// MeshModeDisabled records server metrics for every request, default.
+	MeshModeDisabled MeshMode = "disabled"
+	// MeshModeSuppress skips the request metrics when the request went through an
+	// Envoy sidecar, since the mesh already reports server-side telemetry.
+	MeshModeSuppress MeshMode = "suppress"
+	// MeshModeAnnotate records the request metrics with a mesh="true" attribute when
+	// the request went through an Envoy sidecar, so dashboards can deduplicate.
+	MeshModeAnnotate MeshMode = "annotate"
+)
+
+// MeshModeEnvKey is the environment variable selecting the default mesh mode.
+const MeshModeEnvKey = "METRICS_HTTP_MESH_MODE"
+
+// TraceCorrelationEnvKey is the environment variable enabling the trace correlation
+// attributes by default. Default: false
+const TraceCorrelationEnvKey = "METRICS_HTTP_TRACE_CORRELATION"
+
+// ServiceInstanceIDEnvKey is the environment variable holding the service instance id
+// attached by the trace correlation, the host name is used when unset.
+const ServiceInstanceIDEnvKey = "OTEL_SERVICE_INSTANCE_ID"
+
+type (
+	// MeshMode defines how the middleware behaves for requests proxied by a service mesh.
+	MeshMode string
+
+	// Option configures the HTTP metrics middleware.
+	Option func(*middlewareConfig)
+
+	// middlewareConfig holds the settings of the HTTP metrics middleware.
+	middlewareConfig struct {
+		meshMode MeshMode
+
+		// traceCorrelation enables the service.instance.id and trace.sampled attributes.
+		traceCorrelation bool
+		instanceID       string
+
+		// routes holds the pre-built attributes of the routes registered with WithRoutes.
+		routes map[routeKey]*routeAttrs
+		// collapseUnmatched records the unmatched requests as UnmatchedRoute.
+		collapseUnmatched bool
+		// routeFunc extracts the route of the requests outside of a Chain, the ServeMux pattern if nil.
+		routeFunc func(r *http.Request) string
+
+		// bucketPreset is the bucket layout of the request duration histogram, the SDK default if empty.
+		bucketPreset buckets.Preset
+
+		// deadlineBudget enables the remaining deadline budget histogram, read from the
+		// context deadline and from deadlineHeader if set.
+		deadlineBudget bool
+		deadlineHeader string
+
+		// queueStartHeaders enables the queueing delay histogram, read from the first header set.
+		queueStartHeaders []string
+
+		// securityMetrics enables the security events counter.
+		securityMetrics bool
+
+		// bodyLimit is the maximum request body size in bytes, no limit if not positive.
+		bodyLimit int64
+
+		// apdex computes the Apdex score of the routes, nil if disabled.
+		apdex *apdex
+	}
+)
+
+// NewMeshMode converts a mode name to the corresponding MeshMode, case-insensitive.
+// Returns MeshModeDisabled if the name doesn't match any known mode.
+func NewMeshMode(name string) MeshMode {
+	switch MeshMode(strings.ToLower(strings.TrimSpace(name))) {
+	case MeshModeSuppress:
+		return MeshModeSuppress
+	case MeshModeAnnotate:
+		return MeshModeAnnotate
+	default:
+		return MeshModeDisabled
+	}
+}
+
+// WithMeshMode sets how requests proxied by an Envoy sidecar are recorded,
+// overriding the METRICS_HTTP_MESH_MODE environment variable.
+//
+// Parameters:
+//   - mode: The mesh mode to use
+//
+// Returns:
+//   - An Option setting the mesh mode
+func WithMeshMode(mode MeshMode) Option {
+	return func(c *middlewareConfig) {
+		c.meshMode = mode
+	}
+}
+
+// WithTraceCorrelation attaches the low-cardinality service.instance.id and
+// trace.sampled attributes to the request metrics, so dashboards can be split by
+// instance and by sampled traffic share without exemplar support on the backend.
+//
+// Parameters:
+//   - instanceID: The service instance id, OTEL_SERVICE_INSTANCE_ID or the host name if empty
+//
+// Returns:
+//   - An Option enabling the trace correlation attributes
+func WithTraceCorrelation(instanceID string) Option {
+	return func(c *middlewareConfig) {
+		c.traceCorrelation = true
+		if instanceID != "" {
+			c.instanceID = instanceID
+		}
+	}
+}
+
+// WithBucketPreset sets the bucket layout of the request duration histogram to a
+// curated preset, such as buckets.WebRequest. Unknown presets keep the SDK default.
+//
+// Parameters:
+//   - preset: The bucket preset
+//
+// Returns:
+//   - An Option setting the bucket preset
+func WithBucketPreset(preset buckets.Preset) Option {
+	return func(c *middlewareConfig) {
+		c.bucketPreset = preset
+	}
+}
+
+// defaultInstanceID returns OTEL_SERVICE_INSTANCE_ID or the host name.
+func defaultInstanceID() string {
+	if id := os.Getenv(ServiceInstanceIDEnvKey); id != "" {
+		return id
+	}
+	host, _ := os.Hostname()
+	return host
+}
+
+// newMiddlewareConfig creates the middleware settings from the environment and the options.
+func newMiddlewareConfig(opts ...Option) *middlewareConfig {
+	traceCorrelation, _ := strconv.ParseBool(os.Getenv(TraceCorrelationEnvKey))
+
+	c := &middlewareConfig{
+		meshMode:         NewMeshMode(os.Getenv(MeshModeEnvKey)),
+		traceCorrelation: traceCorrelation,
+		instanceID:       defaultInstanceID(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultQueueStartHeaders are the headers stamped by load balancers and proxies with the
+// time they received the request, read by WithQueueingDelay in this order.
+var DefaultQueueStartHeaders = []string{"X-Request-Start", "X-Queue-Start"}
+
+// WithQueueingDelay records the time spent queued at the load balancer or proxy before
+// the handler ran in the http.request.queueing.delay histogram, to diagnose saturation on
+// the load balancer side. The delay is computed from the first header set among headers,
+// holding the arrival time at the proxy as a Unix timestamp in seconds, milliseconds,
+// microseconds or nanoseconds, optionally prefixed by "t=" as written by nginx and Heroku.
+// Negative delays caused by the clock skew between the proxy and the application are
+// recorded as zero.
+//
+// Parameters:
+//   - headers: The headers holding the arrival time, DefaultQueueStartHeaders if empty
+//
+// Returns:
+//   - An Option enabling the queueing delay histogram
+func WithQueueingDelay(headers ...string) Option {
+	if len(headers) == 0 {
+		headers = DefaultQueueStartHeaders
+	}
+
+	return func(c *middlewareConfig) {
+		c.queueStartHeaders = headers
+	}
+}
+
+// newQueueingHistogram creates the queueing delay histogram.
+func newQueueingHistogram(meter metric.Meter) (metric.Float64Histogram, error) {
+	return meter.Float64Histogram("http.request.queueing.delay",
+		metric.WithDescription("Time spent queued at the load balancer before the handler ran."),
+		metric.WithUnit("s"),
+	)
+}
+
+// recordQueueing records the queueing delay of the request at arrival, if stamped by a proxy.
+func (m *httpMetricsMiddleware) recordQueueing(ctx context.Context, r *http.Request, uri string, arrival time.Time) {
+	for _, header := range m.cfg.queueStartHeaders {
+		queued, ok := parseQueueStart(r.Header.Get(header))
+		if !ok {
+			continue
+		}
+
+		m.queueingDelay.Record(ctx, max(arrival.Sub(queued), 0).Seconds(), metric.WithAttributes(
+			attribute.String("method", r.Method),
+			attribute.String("uri", uri),
+		))
+		return
+	}
+}
+
+// parseQueueStart parses a queue start header value, inferring the timestamp unit from
+// its magnitude.
+func parseQueueStart(value string) (time.Time, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "t=")
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	ts, err := strconv.ParseFloat(value, 64)
+	if err != nil || ts <= 0 || math.IsInf(ts, 0) {
+		return time.Time{}, false
+	}
+
+	// Timestamps after 2001 have 10 digits in seconds, 13 in milliseconds and 16 in microseconds
+	switch {
+	case ts < 1e11:
+		ts *= 1e9
+	case ts < 1e14:
+		ts *= 1e6
+	case ts < 1e17:
+		ts *= 1e3
+	}
+
+	return time.Unix(0, int64(ts)), true
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/goxkit/metrics/sanitize"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// UnmatchedRoute is the uri attribute of the requests collapsed by WithUnmatchedRoute.
+const UnmatchedRoute = "unmatched"
+
+type (
+	// Route is a route known at startup, whose attribute sets are built ahead of time.
+	Route struct {
+		// Method is the request method, such as http.MethodGet.
+		Method string
+		// Pattern is the route recorded as the uri attribute, such as the ServeMux
+		// pattern "GET /orders/{id}" shared by a Chain or returned by the route
+		// function of WithRouteFunc.
+		Pattern string
+	}
+
+	// routeKey identifies a pre-registered route at request time.
+	routeKey struct {
+		method string
+		route  string
+	}
+
+	// routeAttrs holds the pre-built measurement options of a route, indexed by
+	// status class (1xx to 5xx) and by the status code within the class.
+	routeAttrs [5][100]metric.MeasurementOption
+)
+
+// WithRoutes pre-registers the known routes so the middleware builds all their
+// attribute sets at startup. Requests matching a route with a standard status code
+// are then recorded without any per-request attribute construction.
+//
+// Outside of a Chain, the route of a request is extracted once the wrapped handler
+// returned, from the http.ServeMux pattern by default, see WithRouteFunc. Requests
+// recorded with the mesh or trace correlation attributes, or with an explicit
+// operation, fall back to building their attributes.
+//
+// Parameters:
+//   - routes: The routes served by the application
+//
+// Returns:
+//   - An Option pre-registering the routes
+func WithRoutes(routes ...Route) Option {
+	return func(c *middlewareConfig) {
+		if c.routes == nil {
+			c.routes = make(map[routeKey]*routeAttrs, len(routes))
+		}
+		for _, r := range routes {
+			c.routes[routeKey{method: r.Method, route: r.Pattern}] = newRouteAttrs(r)
+		}
+	}
+}
+
+// WithUnmatchedRoute records the requests not matching any route with the
+// uri="unmatched" attribute, protecting the backend from scanners generating
+// unique URI series. A request is unmatched when no route was extracted, by the
+// Chain or, outside of a Chain, by the route function of WithRouteFunc, or when its
+// route isn't registered with WithRoutes.
+//
+// Returns:
+//   - An Option collapsing the unmatched routes
+func WithUnmatchedRoute() Option {
+	return func(c *middlewareConfig) {
+		c.collapseUnmatched = true
+	}
+}
+
+// WithRouteFunc sets the function extracting the route of a request outside of a
+// Chain, once the wrapped handler returned, for the routes registered with WithRoutes
+// and WithUnmatchedRoute. The default uses the http.ServeMux pattern, such as
+// "GET /orders/{id}", so the middleware must wrap the ServeMux.
+//
+// Parameters:
+//   - fn: The function returning the route of the request, empty if none matched
+//
+// Returns:
+//   - An Option setting the route extractor
+func WithRouteFunc(fn func(r *http.Request) string) Option {
+	return func(c *middlewareConfig) {
+		c.routeFunc = fn
+	}
+}
+
+// route returns the uri attribute of a request, once the wrapped handler returned.
+// Within a Chain, the route is the one shared by the Chain. Outside of a Chain, with
+// WithRoutes or WithUnmatchedRoute, it is extracted by the route function. Without a
+// route, the uri is the sanitized request URI, collapsed to UnmatchedRoute when enabled.
+func (c *middlewareConfig) route(r *http.Request) string {
+	var route string
+	if info := RequestInfoFromContext(r.Context()); info != nil {
+		route = info.Route
+	} else if c.routes != nil || c.collapseUnmatched {
+		route = c.extractRoute(r)
+	}
+
+	if !c.collapseUnmatched {
+		if route == "" {
+			return sanitize.Value(r.RequestURI)
+		}
+		return route
+	}
+	if route == "" {
+		return UnmatchedRoute
+	}
+	if c.routes != nil {
+		if _, ok := c.routes[routeKey{method: r.Method, route: route}]; !ok {
+			return UnmatchedRoute
+		}
+	}
+	return route
+}
+
+// extractRoute returns the route of a request outside of a Chain, the ServeMux
+// pattern by default.
+func (c *middlewareConfig) extractRoute(r *http.Request) string {
+	if c.routeFunc != nil {
+		return c.routeFunc(r)
+	}
+	return r.Pattern
+}
+
+// newRouteAttrs builds the measurement options of every standard status code of the route.
+func newRouteAttrs(r Route) *routeAttrs {
+	attrs := &routeAttrs{}
+	for code := 100; code < 600; code++ {
+		if http.StatusText(code) == "" {
+			continue
+		}
+		attrs[code/100-1][code%100] = metric.WithAttributeSet(attribute.NewSet(
+			attribute.String("method", r.Method),
+			attribute.String("uri", r.Pattern),
+			attribute.Int("statusCode", code),
+		))
+	}
+	return attrs
+}
+
+// lookup returns the pre-built measurement option of the route and status code,
+// or nil if the route is not registered or the status code is not standard.
+func (c *middlewareConfig) lookup(method, route string, code int) metric.MeasurementOption {
+	if code < 100 || code >= 600 {
+		return nil
+	}
+
+	attrs, ok := c.routes[routeKey{method: method, route: route}]
+	if !ok {
+		return nil
+	}
+	return attrs[code/100-1][code%100]
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// newRoutesMux returns a ServeMux serving GET /orders/{id}.
+func newRoutesMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orders/{id}", func(http.ResponseWriter, *http.Request) {})
+	return mux
+}
+
+// setTestProvider sets a MeterProvider collected by the returned reader as the global
+// provider until the end of the test.
+func setTestProvider(t *testing.T) *sdkmetric.ManualReader {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(provider)
+	t.Cleanup(func() {
+		otel.SetMeterProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return reader
+}
+
+// serve sends a GET request for each target to handler.
+func serve(handler http.Handler, targets ...string) {
+	for _, target := range targets {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+	}
+}
+
+// requestURIs collects reader and returns the http.requests count by uri.
+func requestURIs(t *testing.T, reader sdkmetric.Reader) map[string]int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	uris := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok && m.Name == "http.requests" {
+				for _, dp := range sum.DataPoints {
+					uri, _ := dp.Attributes.Value("uri")
+					uris[uri.AsString()] += dp.Value
+				}
+			}
+		}
+	}
+	return uris
+}
+
+// TestRoutesWithoutChain verifies that the middleware wrapping a ServeMux records the
+// pre-registered routes by their pattern.
+func TestRoutesWithoutChain(t *testing.T) {
+	reader := setTestProvider(t)
+
+	middleware, err := NewHTTPMetricsMiddleware(WithRoutes(Route{Method: http.MethodGet, Pattern: "GET /orders/{id}"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve(middleware.Handler(newRoutesMux()), "/orders/1", "/orders/2")
+
+	if got := requestURIs(t, reader); len(got) != 1 || got["GET /orders/{id}"] != 2 {
+		t.Errorf("got uris %v, want 2 requests on GET /orders/{id}", got)
+	}
+}
+
+// TestRoutesWithRouteFunc verifies that the route function replaces the ServeMux
+// pattern outside of a Chain.
+func TestRoutesWithRouteFunc(t *testing.T) {
+	reader := setTestProvider(t)
+
+	middleware, err := NewHTTPMetricsMiddleware(
+		WithRoutes(Route{Method: http.MethodGet, Pattern: "/orders/:id"}),
+		WithRouteFunc(func(*http.Request) string { return "/orders/:id" }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve(middleware.Handler(http.NotFoundHandler()), "/orders/1")
+
+	if got := requestURIs(t, reader); len(got) != 1 || got["/orders/:id"] != 1 {
+		t.Errorf("got uris %v, want 1 request on /orders/:id", got)
+	}
+}
+
+// TestRoutesWithChain verifies that the Chain records the pre-registered routes by
+// the route it shares.
+func TestRoutesWithChain(t *testing.T) {
+	reader := setTestProvider(t)
+
+	chain, err := Chain(nil, WithMetricsOptions(WithRoutes(Route{Method: http.MethodGet, Pattern: "GET /orders/{id}"})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve(chain(newRoutesMux()), "/orders/1", "/orders/2")
+
+	if got := requestURIs(t, reader); len(got) != 1 || got["GET /orders/{id}"] != 2 {
+		t.Errorf("got uris %v, want 2 requests on GET /orders/{id}", got)
+	}
+}
+
+// TestUnmatchedRoute verifies that the requests without a registered route are
+// collapsed, within a Chain and with the middleware wrapping a ServeMux.
+func TestUnmatchedRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(t *testing.T, opts ...Option) http.Handler
+		opts    []Option
+		want    map[string]int64
+	}{
+		{
+			name:    "without chain",
+			handler: middlewareHandler,
+			opts:    []Option{WithUnmatchedRoute()},
+			want:    map[string]int64{"GET /orders/{id}": 1, UnmatchedRoute: 2},
+		},
+		{
+			name:    "without chain, registered routes",
+			handler: middlewareHandler,
+			opts:    []Option{WithUnmatchedRoute(), WithRoutes(Route{Method: http.MethodGet, Pattern: "GET /users/{id}"})},
+			want:    map[string]int64{UnmatchedRoute: 3},
+		},
+		{
+			name:    "with chain",
+			handler: chainHandler,
+			opts:    []Option{WithUnmatchedRoute()},
+			want:    map[string]int64{"GET /orders/{id}": 1, UnmatchedRoute: 2},
+		},
+		{
+			name:    "with chain, registered routes",
+			handler: chainHandler,
+			opts:    []Option{WithUnmatchedRoute(), WithRoutes(Route{Method: http.MethodGet, Pattern: "GET /orders/{id}"})},
+			want:    map[string]int64{"GET /orders/{id}": 1, UnmatchedRoute: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := setTestProvider(t)
+			serve(tt.handler(t, tt.opts...), "/orders/1", "/.env", "/wp-login.php")
+
+			got := requestURIs(t, reader)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got uris %v, want %v", got, tt.want)
+			}
+			for uri, n := range tt.want {
+				if got[uri] != n {
+					t.Errorf("got uris %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// middlewareHandler returns the ServeMux of newRoutesMux wrapped by the middleware.
+func middlewareHandler(t *testing.T, opts ...Option) http.Handler {
+	t.Helper()
+
+	middleware, err := NewHTTPMetricsMiddleware(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return middleware.Handler(newRoutesMux())
+}
+
+// chainHandler returns the ServeMux of newRoutesMux wrapped by a Chain.
+func chainHandler(t *testing.T, opts ...Option) http.Handler {
+	t.Helper()
+
+	chain, err := Chain(nil, WithMetricsOptions(opts...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chain(newRoutesMux())
+}
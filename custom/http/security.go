@@ -0,0 +1,78 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Security events recorded by WithSecurityMetrics, as the security.event attribute.
+const (
+	// SecurityEventAuthn is a request rejected with 401 Unauthorized.
+	SecurityEventAuthn = "authn_failure"
+	// SecurityEventAuthz is a request rejected with 403 Forbidden.
+	SecurityEventAuthz = "authz_failure"
+	// SecurityEventPayloadTooLarge is a request rejected with 413 Content Too Large.
+	SecurityEventPayloadTooLarge = "payload_too_large"
+	// SecurityEventRateLimited is a request rejected with 429 Too Many Requests.
+	SecurityEventRateLimited = "rate_limited"
+)
+
+// WithSecurityMetrics counts the security-relevant rejections in the
+// http.security.events counter, giving basic detection signals from the middleware:
+// authentication and authorization failures, rejected payload sizes and rate limit
+// rejections, inferred from the response status code. The counter attributes are
+// bounded to the security.event, method and uri attributes, the uri being the route
+// when known, so attackers can't create series at will.
+//
+// Returns:
+//   - An Option enabling the security counter
+func WithSecurityMetrics() Option {
+	return func(c *middlewareConfig) {
+		c.securityMetrics = true
+	}
+}
+
+// newSecurityCounter creates the security events counter.
+func newSecurityCounter(meter metric.Meter) (metric.Int64Counter, error) {
+	return meter.Int64Counter("http.security.events",
+		metric.WithDescription("Security-relevant request rejections, by event."),
+		metric.WithUnit("{request}"),
+	)
+}
+
+// securityEvent returns the security event of a response status code.
+func securityEvent(statusCode int) (string, bool) {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return SecurityEventAuthn, true
+	case http.StatusForbidden:
+		return SecurityEventAuthz, true
+	case http.StatusRequestEntityTooLarge:
+		return SecurityEventPayloadTooLarge, true
+	case http.StatusTooManyRequests:
+		return SecurityEventRateLimited, true
+	default:
+		return "", false
+	}
+}
+
+// recordSecurity records the security event of the response, if any.
+func (m *httpMetricsMiddleware) recordSecurity(ctx context.Context, r *http.Request, uri string, statusCode int) {
+	event, ok := securityEvent(statusCode)
+	if !ok {
+		return
+	}
+
+	m.securityEvents.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("security.event", event),
+		attribute.String("method", r.Method),
+		attribute.String("uri", uri),
+	))
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package logs counts the log records exported by the OTLP logging pipeline, such as
+// the one set up by goxkit/logging, and exports the counts as metrics, so teams can
+// reconcile the log volume with the billing and spot log pipeline drops from the
+// metrics dashboards.
+//
+// CountingExporter wraps any exporter with the method set of the OpenTelemetry log SDK
+// Exporter, without importing the log SDK. It is created for the sdklog.Record type
+// and satisfies the sdklog.Exporter interface:
+//
+//	exp, err := otlploggrpc.New(ctx)
+//	counted, err := logs.NewCountingExporter[sdklog.Record](exp, nil)
+//	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewBatchProcessor(counted)))
+package logs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// Exporter is the method set of the OpenTelemetry log SDK Exporter, for the record type R.
+	Exporter[R any] interface {
+		Export(ctx context.Context, records []R) error
+		Shutdown(ctx context.Context) error
+		ForceFlush(ctx context.Context) error
+	}
+
+	// CountingExporter is an Exporter counting the records it exports:
+	//   - log.records.exported: counter of the records exported successfully
+	//   - log.records.failed: counter of the records whose export failed, dropped by the pipeline
+	//
+	// It is safe for concurrent use if the wrapped exporter is.
+	CountingExporter[R any] struct {
+		exporter Exporter[R]
+		opt      metric.MeasurementOption
+
+		exported metric.Int64Counter
+		failed   metric.Int64Counter
+	}
+)
+
+// NewCountingExporter wraps exporter to count the records it exports.
+//
+// Parameters:
+//   - exporter: The wrapped log exporter
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//   - attrs: The attributes of the counters, such as the pipeline name
+//
+// Returns:
+//   - The CountingExporter
+//   - An error if the meter instruments cannot be created
+func NewCountingExporter[R any](exporter Exporter[R], meter metric.Meter, attrs ...attribute.KeyValue) (*CountingExporter[R], error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/logs")
+	}
+
+	exported, err := meter.Int64Counter("log.records.exported", metric.WithDescription("Log records exported successfully."), metric.WithUnit("{record}"))
+	if err != nil {
+		return nil, err
+	}
+
+	failed, err := meter.Int64Counter("log.records.failed", metric.WithDescription("Log records whose export failed."), metric.WithUnit("{record}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CountingExporter[R]{
+		exporter: exporter,
+		opt:      metric.WithAttributes(attrs...),
+		exported: exported,
+		failed:   failed,
+	}, nil
+}
+
+// Export exports the records with the wrapped exporter and counts them.
+//
+// Parameters:
+//   - ctx: The context of the export
+//   - records: The log records
+//
+// Returns:
+//   - The error of the wrapped exporter
+func (e *CountingExporter[R]) Export(ctx context.Context, records []R) error {
+	err := e.exporter.Export(ctx, records)
+	if len(records) == 0 {
+		return err
+	}
+
+	if err != nil {
+		e.failed.Add(ctx, int64(len(records)), e.opt)
+		return err
+	}
+
+	e.exported.Add(ctx, int64(len(records)), e.opt)
+	return nil
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *CountingExporter[R]) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped exporter.
+func (e *CountingExporter[R]) ForceFlush(ctx context.Context) error {
+	return e.exporter.ForceFlush(ctx)
+}
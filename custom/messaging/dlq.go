@@ -0,0 +1,144 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package messaging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+const (
+	// DefaultDLQInterval is the default interval between two dead-letter queue depth polls.
+	DefaultDLQInterval = 30 * time.Second
+	// DefaultDLQTimeout is the timeout of a dead-letter queue depth poll.
+	DefaultDLQTimeout = 10 * time.Second
+)
+
+type (
+	// DepthFunc returns the current depth of the dead-letter queues, by queue name.
+	// It usually queries the broker management API, such as the RabbitMQ queue
+	// declaration or the Kafka consumer group lag of the DLQ topics.
+	DepthFunc func(ctx context.Context) (map[string]int64, error)
+
+	// DLQObserver polls the dead-letter queue depths every interval and exports them
+	// as the messaging.dlq.depth gauge, with the messaging.system and
+	// messaging.destination.name attributes.
+	//
+	// Polls run in the background so a slow broker API never delays a collection.
+	// A failed poll is reported to the OpenTelemetry error handler and drops the
+	// depths, so stale values are not exported.
+	DLQObserver struct {
+		depthFunc DepthFunc
+		interval  time.Duration
+		system    metric.MeasurementOption
+
+		depth metric.Int64ObservableGauge
+
+		mu     sync.RWMutex
+		depths map[string]int64
+
+		stop     chan struct{}
+		stopOnce sync.Once
+	}
+)
+
+// NewDLQObserver creates a DLQObserver with instruments created by the given meter
+// and starts the goroutine polling the dead-letter queue depths.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//   - system: The messaging system, such as SystemRabbitMQ
+//   - interval: The interval between two polls, DefaultDLQInterval if not positive
+//   - depthFunc: The function returning the dead-letter queue depths
+//
+// Returns:
+//   - A started DLQObserver
+//   - An error if the meter instruments cannot be created
+func NewDLQObserver(meter metric.Meter, system string, interval time.Duration, depthFunc DepthFunc) (*DLQObserver, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/messaging")
+	}
+	if interval <= 0 {
+		interval = DefaultDLQInterval
+	}
+
+	depth, err := meter.Int64ObservableGauge("messaging.dlq.depth", metric.WithDescription("Number of messages waiting in the dead-letter queue."), metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	o := &DLQObserver{
+		depthFunc: depthFunc,
+		interval:  interval,
+		system:    metric.WithAttributes(semconv.MessagingSystemKey.String(system)),
+		depth:     depth,
+		stop:      make(chan struct{}),
+	}
+
+	if _, err := meter.RegisterCallback(o.observe, depth); err != nil {
+		return nil, err
+	}
+
+	go o.run()
+
+	return o, nil
+}
+
+// Stop stops polling the dead-letter queue depths.
+func (o *DLQObserver) Stop() {
+	o.stopOnce.Do(func() {
+		close(o.stop)
+	})
+}
+
+// run polls the depths immediately, then every interval until the observer is stopped.
+func (o *DLQObserver) run() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		o.poll()
+
+		select {
+		case <-o.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll calls the depth function and stores the depths.
+func (o *DLQObserver) poll() {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultDLQTimeout)
+	defer cancel()
+
+	depths, err := o.depthFunc(ctx)
+	if err != nil {
+		otel.Handle(err)
+		depths = nil
+	}
+
+	o.mu.Lock()
+	o.depths = depths
+	o.mu.Unlock()
+}
+
+// observe reports the depths of the last poll.
+func (o *DLQObserver) observe(_ context.Context, observer metric.Observer) error {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	for queue, depth := range o.depths {
+		observer.ObserveInt64(o.depth, depth, o.system, metric.WithAttributes(semconv.MessagingDestinationName(queue)))
+	}
+
+	return nil
+}
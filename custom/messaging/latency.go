@@ -0,0 +1,88 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package messaging
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+const (
+	// PublishTimeHeader is the message header holding the publish time, in Unix milliseconds.
+	PublishTimeHeader = "x-publish-time"
+	// ClockSkewTolerance is the largest negative latency, caused by the clock skew between
+	// the producer and the consumer, recorded as zero. Larger skews are discarded.
+	ClockSkewTolerance = time.Second
+	// MaxLatency is the largest recorded latency, longer latencies denote a wrong producer
+	// clock or a replayed message and are discarded.
+	MaxLatency = 7 * 24 * time.Hour
+)
+
+// latencyBuckets are the histogram boundaries of the end-to-end latencies, from 5ms to 1h.
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300, 900, 3600}
+
+// StampPublishTime sets the publish time header of a message. Headers are accessed through
+// a propagation.TextMapCarrier, such as propagation.HeaderCarrier for NATS headers or an
+// adapter of the Kafka record headers and the AMQP table.
+//
+// Parameters:
+//   - carrier: The message headers
+//   - t: The publish time, usually time.Now()
+func StampPublishTime(carrier propagation.TextMapCarrier, t time.Time) {
+	carrier.Set(PublishTimeHeader, strconv.FormatInt(t.UnixMilli(), 10))
+}
+
+// PublishTime returns the publish time stamped in the message headers.
+//
+// Parameters:
+//   - carrier: The message headers
+//
+// Returns:
+//   - The publish time
+//   - false if the header is missing or malformed
+func PublishTime(carrier propagation.TextMapCarrier) (time.Time, bool) {
+	ms, err := strconv.ParseInt(carrier.Get(PublishTimeHeader), 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}, false
+	}
+
+	return time.UnixMilli(ms), true
+}
+
+// EndToEnd records the latency from the publication of a message, read from its publish
+// time header, to now. It should be called once the message is processed.
+//
+// Negative latencies within ClockSkewTolerance are recorded as zero, while larger skews
+// and latencies above MaxLatency are counted by messaging.e2e.latency.skewed instead of
+// polluting the histogram. Messages without the header are ignored.
+//
+// Parameters:
+//   - ctx: The context of the processing
+//   - destination: The destination name the message was consumed from
+//   - carrier: The message headers
+func (m *Metrics) EndToEnd(ctx context.Context, destination string, carrier propagation.TextMapCarrier) {
+	published, ok := PublishTime(carrier)
+	if !ok {
+		return
+	}
+
+	opt := metric.WithAttributes(m.system, semconv.MessagingOperationTypeProcess, semconv.MessagingDestinationName(destination))
+
+	latency := time.Since(published)
+	switch {
+	case latency < -ClockSkewTolerance || latency > MaxLatency:
+		m.skewed.Add(ctx, 1, opt)
+		return
+	case latency < 0:
+		latency = 0
+	}
+
+	m.latency.Record(ctx, latency.Seconds(), opt)
+}
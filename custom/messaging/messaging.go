@@ -0,0 +1,132 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package messaging provides broker-agnostic instrumentation for the Kafka, AMQP
+// and NATS clients. The client adapters of the application report the published
+// and consumed messages to a Metrics value, so dashboards use the same queries
+// whatever the broker, distinguished by the messaging.system attribute.
+package messaging
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+// Messaging systems reported by the messaging.system attribute.
+const (
+	SystemKafka    = "kafka"
+	SystemRabbitMQ = "rabbitmq"
+	SystemNATS     = "nats"
+)
+
+// payloadSizeBuckets are the histogram boundaries of the payload sizes, from 128B to 16MiB.
+var payloadSizeBuckets = []float64{128, 512, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216}
+
+// Metrics records the messaging metrics of a broker client. It is safe for concurrent use.
+type Metrics struct {
+	system attribute.KeyValue
+
+	payloadSize     metric.Int64Histogram
+	bytes           metric.Int64Counter
+	processed       metric.Int64Counter
+	processDuration metric.Float64Histogram
+	latency         metric.Float64Histogram
+	skewed          metric.Int64Counter
+}
+
+// NewMetrics creates the messaging metrics of a broker client and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//   - system: The messaging system, such as SystemKafka
+//
+// Returns:
+//   - The Metrics of the client
+//   - An error if the meter instruments cannot be created
+func NewMetrics(meter metric.Meter, system string) (*Metrics, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/messaging")
+	}
+
+	payloadSize, err := meter.Int64Histogram("messaging.message.size",
+		metric.WithDescription("Payload size of the published and consumed messages."),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(payloadSizeBuckets...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := meter.Int64Counter("messaging.client.bytes", metric.WithDescription("Payload bytes published and consumed, by destination."), metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
+	processed, err := meter.Int64Counter("messaging.process.messages", metric.WithDescription("Consumed messages processed, by outcome."), metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	processDuration, err := meter.Float64Histogram("messaging.process.duration", metric.WithDescription("Processing duration of the consumed messages, by outcome."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	latency, err := meter.Float64Histogram("messaging.e2e.latency",
+		metric.WithDescription("Latency from the publication to the processing of the consumed messages."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(latencyBuckets...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	skewed, err := meter.Int64Counter("messaging.e2e.latency.skewed", metric.WithDescription("End-to-end latencies discarded because of the clock skew between the producer and the consumer."), metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		system:          semconv.MessagingSystemKey.String(system),
+		payloadSize:     payloadSize,
+		bytes:           bytes,
+		processed:       processed,
+		processDuration: processDuration,
+		latency:         latency,
+		skewed:          skewed,
+	}, nil
+}
+
+// Published records a message published to destination, a topic, exchange or subject.
+//
+// Parameters:
+//   - ctx: The context of the publication
+//   - destination: The destination name
+//   - size: The payload size in bytes
+func (m *Metrics) Published(ctx context.Context, destination string, size int) {
+	m.recordSize(ctx, semconv.MessagingOperationTypeSend, destination, size)
+}
+
+// Received records a message consumed from destination, a topic, queue or subject.
+//
+// Parameters:
+//   - ctx: The context of the consumption
+//   - destination: The destination name
+//   - size: The payload size in bytes
+func (m *Metrics) Received(ctx context.Context, destination string, size int) {
+	m.recordSize(ctx, semconv.MessagingOperationTypeReceive, destination, size)
+}
+
+// recordSize records the payload size and throughput of a message.
+func (m *Metrics) recordSize(ctx context.Context, operation attribute.KeyValue, destination string, size int) {
+	opt := metric.WithAttributes(m.system, operation, semconv.MessagingDestinationName(destination))
+
+	m.payloadSize.Record(ctx, int64(size), opt)
+	m.bytes.Add(ctx, int64(size), opt)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+// OutcomeKey is the attribute key of the consumer processing outcome.
+const OutcomeKey = attribute.Key("messaging.consumer.outcome")
+
+// Outcome is the result of the processing of a consumed message, shared by all the
+// brokers so cross-broker dashboards use identical queries.
+type Outcome string
+
+// Processing outcomes of the consumed messages.
+const (
+	// OutcomeAck is a message processed and acknowledged.
+	OutcomeAck Outcome = "ack"
+	// OutcomeNackRequeue is a message rejected and requeued for redelivery.
+	OutcomeNackRequeue Outcome = "nack_requeue"
+	// OutcomeNackDrop is a message rejected and discarded.
+	OutcomeNackDrop Outcome = "nack_drop"
+	// OutcomeDLQ is a message routed to the dead-letter queue.
+	OutcomeDLQ Outcome = "dlq"
+)
+
+// Attribute returns the attribute reporting the outcome.
+func (o Outcome) Attribute() attribute.KeyValue {
+	return OutcomeKey.String(string(o))
+}
+
+// Processed records the processing of a message consumed from destination.
+//
+// Parameters:
+//   - ctx: The context of the processing
+//   - destination: The destination name the message was consumed from
+//   - outcome: The processing outcome
+//   - duration: The processing duration
+func (m *Metrics) Processed(ctx context.Context, destination string, outcome Outcome, duration time.Duration) {
+	opt := metric.WithAttributes(m.system, semconv.MessagingOperationTypeProcess, semconv.MessagingDestinationName(destination), outcome.Attribute())
+
+	m.processed.Add(ctx, 1, opt)
+	m.processDuration.Record(ctx, duration.Seconds(), opt)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package notify provides instrumentation for email and notification senders,
+// recording the send attempts, the failures by error class and the delivery
+// latency, the building blocks of transactional mail SLOs.
+package notify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Notification channels reported by the notification.channel attribute.
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelPush  = "push"
+)
+
+// Error classes reported by the error.class attribute of the failures.
+const (
+	// ErrorClassTimeout is used when the send timed out.
+	ErrorClassTimeout = "timeout"
+	// ErrorClassCanceled is used when the send context was canceled.
+	ErrorClassCanceled = "canceled"
+	// ErrorClassConnection is used when the server couldn't be reached.
+	ErrorClassConnection = "connection"
+	// ErrorClassAuth is used when the server rejected the credentials, SMTP 530 and 535.
+	ErrorClassAuth = "auth"
+	// ErrorClassTemporary is used for the transient server errors, SMTP 4xx.
+	ErrorClassTemporary = "temporary"
+	// ErrorClassRejected is used for the permanent server errors, SMTP 5xx.
+	ErrorClassRejected = "rejected"
+	// ErrorClassUnknown is used when no other class matches.
+	ErrorClassUnknown = "unknown"
+)
+
+type (
+	// Classifier returns the error class of a failed send.
+	Classifier func(err error) string
+
+	// Sender records the metrics of the sends of a notification channel.
+	// It is safe for concurrent use.
+	Sender struct {
+		attempts metric.Int64Counter
+		failures metric.Int64Counter
+		duration metric.Float64Histogram
+		channel  attribute.KeyValue
+		classify Classifier
+	}
+)
+
+// NewSender creates the metrics of a notification channel and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//   - channel: The notification channel, such as ChannelEmail
+//   - classify: The error classifier, Classify if nil
+//
+// Returns:
+//   - The Sender recording the sends
+//   - An error if the meter instruments cannot be created
+func NewSender(meter metric.Meter, channel string, classify Classifier) (*Sender, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/notify")
+	}
+	if classify == nil {
+		classify = Classify
+	}
+
+	attempts, err := meter.Int64Counter("notification.send.attempts", metric.WithDescription("Number of notification send attempts."), metric.WithUnit("{attempt}"))
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := meter.Int64Counter("notification.send.failures", metric.WithDescription("Number of failed notification sends, by error class."), metric.WithUnit("{attempt}"))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("notification.send.duration", metric.WithDescription("Latency of the notification sends until accepted by the server."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sender{
+		attempts: attempts,
+		failures: failures,
+		duration: duration,
+		channel:  attribute.String("notification.channel", channel),
+		classify: classify,
+	}, nil
+}
+
+// Send calls send and records the attempt, its latency and, on failure, its error class.
+//
+// Parameters:
+//   - ctx: The context of the send, passed to send
+//   - send: The function sending the notification, such as a smtp.SendMail call
+//
+// Returns:
+//   - The error returned by send
+func (s *Sender) Send(ctx context.Context, send func(ctx context.Context) error) error {
+	start := time.Now()
+	err := send(ctx)
+	s.Record(ctx, time.Since(start), err)
+	return err
+}
+
+// Record records a send attempt that took duration and failed with err, nil on success.
+//
+// Parameters:
+//   - ctx: The context of the send
+//   - duration: The latency of the send
+//   - err: The error of the send, nil on success
+func (s *Sender) Record(ctx context.Context, duration time.Duration, err error) {
+	opt := metric.WithAttributes(s.channel)
+
+	s.attempts.Add(ctx, 1, opt)
+	s.duration.Record(ctx, duration.Seconds(), opt)
+	if err != nil {
+		s.failures.Add(ctx, 1, metric.WithAttributes(s.channel, attribute.String("error.class", s.classify(err))))
+	}
+}
+
+// Classify returns the error class of a failed send, recognizing context errors,
+// network errors and SMTP reply codes carried by a *textproto.Error.
+//
+// Parameters:
+//   - err: The send error
+//
+// Returns:
+//   - One of the ErrorClass constants
+func Classify(err error) string {
+	var (
+		protoErr *textproto.Error
+		netErr   net.Error
+		opErr    *net.OpError
+	)
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.As(err, &protoErr):
+		switch {
+		case protoErr.Code == 530 || protoErr.Code == 535:
+			return ErrorClassAuth
+		case protoErr.Code >= 400 && protoErr.Code < 500:
+			return ErrorClassTemporary
+		case protoErr.Code >= 500:
+			return ErrorClassRejected
+		}
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorClassTimeout
+	case errors.As(err, &opErr):
+		return ErrorClassConnection
+	}
+
+	return ErrorClassUnknown
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package probe provides built-in blackbox monitoring from within the application:
+// probe functions, such as HTTP GET or TCP connect, are registered with an interval
+// and executed by a Scheduler that exports their success, latency and consecutive
+// failures.
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// DefaultInterval is the default interval between two runs of a probe.
+	DefaultInterval = time.Minute
+	// DefaultTimeout is the default timeout of a probe run.
+	DefaultTimeout = 10 * time.Second
+)
+
+type (
+	// Func runs a probe, returning an error if it fails.
+	Func func(ctx context.Context) error
+
+	// Probe describes a probe executed by the Scheduler.
+	Probe struct {
+		// Name identifies the probe, reported as the probe attribute.
+		Name string
+		// Func is the probe function.
+		Func Func
+		// Interval is the time between two runs. Default: DefaultInterval
+		Interval time.Duration
+		// Timeout bounds a run. Default: DefaultTimeout
+		Timeout time.Duration
+	}
+
+	// Scheduler runs the registered probes and reports the probe.success gauge, 1
+	// when the last run succeeded and 0 otherwise, the probe.duration histogram and
+	// the probe.consecutive_failures gauge, all with the probe attribute.
+	//
+	// A Scheduler is safe for concurrent use.
+	Scheduler struct {
+		success             metric.Int64ObservableGauge
+		consecutiveFailures metric.Int64ObservableGauge
+		duration            metric.Float64Histogram
+
+		mu     sync.Mutex
+		probes map[string]*probeState
+	}
+
+	// probeState holds a running probe and the result of its last run.
+	probeState struct {
+		probe Probe
+		attrs metric.MeasurementOption
+		stop  chan struct{}
+
+		ran      bool
+		success  bool
+		failures int64
+	}
+)
+
+// NewScheduler creates a Scheduler with instruments created by the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//
+// Returns:
+//   - A new Scheduler
+//   - An error if the instruments cannot be created
+func NewScheduler(meter metric.Meter) (*Scheduler, error) {
+	success, err := meter.Int64ObservableGauge("probe.success", metric.WithDescription("Whether the last probe run succeeded (1) or not (0)."))
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := meter.Int64ObservableGauge("probe.consecutive_failures", metric.WithDescription("Number of consecutive failed probe runs."))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("probe.duration", metric.WithDescription("Duration of the probe runs."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scheduler{
+		success:             success,
+		consecutiveFailures: failures,
+		duration:            duration,
+		probes:              map[string]*probeState{},
+	}
+
+	if _, err := meter.RegisterCallback(s.observe, success, failures); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Register starts running the probe immediately, then every interval. A probe
+// registered with the name of a running probe replaces it.
+//
+// Parameters:
+//   - p: The probe to run
+func (s *Scheduler) Register(p Probe) {
+	if p.Interval <= 0 {
+		p.Interval = DefaultInterval
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultTimeout
+	}
+
+	state := &probeState{
+		probe: p,
+		attrs: metric.WithAttributes(attribute.String("probe", p.Name)),
+		stop:  make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	if previous, ok := s.probes[p.Name]; ok {
+		close(previous.stop)
+	}
+	s.probes[p.Name] = state
+	s.mu.Unlock()
+
+	go s.run(state)
+}
+
+// Unregister stops running and reporting the named probe.
+//
+// Parameters:
+//   - name: The probe name
+func (s *Scheduler) Unregister(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, ok := s.probes[name]; ok {
+		close(state.stop)
+		delete(s.probes, name)
+	}
+}
+
+// Stop stops running all the probes.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, state := range s.probes {
+		close(state.stop)
+		delete(s.probes, name)
+	}
+}
+
+// run runs the probe every interval until it is stopped.
+func (s *Scheduler) run(state *probeState) {
+	ticker := time.NewTicker(state.probe.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.execute(state)
+
+		select {
+		case <-state.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// execute runs the probe once and records its result.
+func (s *Scheduler) execute(state *probeState) {
+	ctx, cancel := context.WithTimeout(context.Background(), state.probe.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := state.probe.Func(ctx)
+	s.duration.Record(ctx, time.Since(start).Seconds(), state.attrs)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state.ran = true
+	state.success = err == nil
+	if err != nil {
+		state.failures++
+	} else {
+		state.failures = 0
+	}
+}
+
+// observe reports the result of the last run of every probe.
+func (s *Scheduler) observe(_ context.Context, observer metric.Observer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, state := range s.probes {
+		if !state.ran {
+			continue
+		}
+
+		var success int64
+		if state.success {
+			success = 1
+		}
+		observer.ObserveInt64(s.success, success, state.attrs)
+		observer.ObserveInt64(s.consecutiveFailures, state.failures, state.attrs)
+	}
+
+	return nil
+}
+
+// HTTPGet returns a probe sending a GET request to url, failing on transport errors
+// and non 2xx or 3xx responses.
+//
+// Parameters:
+//   - url: The probed URL
+//   - client: The HTTP client sending the request, http.DefaultClient if nil
+//
+// Returns:
+//   - The probe function
+func HTTPGet(url string, client *http.Client) Func {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("GET %s responded with status %d", url, resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// TCPConnect returns a probe opening a TCP connection to address.
+//
+// Parameters:
+//   - address: The probed host:port address
+//
+// Returns:
+//   - The probe function
+func TCPConnect(address string) Func {
+	return func(ctx context.Context) error {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
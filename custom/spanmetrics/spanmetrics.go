@@ -0,0 +1,176 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package spanmetrics aggregates the ended spans into RED metrics per operation on the
+// client side, for teams that can't run the spanmetrics connector of the OpenTelemetry
+// Collector. The Processor is an SDK SpanProcessor, registered in the TracerProvider,
+// such as the one built by the goxkit tracing package.
+package spanmetrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// durationBuckets are the histogram boundaries of the span durations, in seconds.
+var durationBuckets = []float64{0.002, 0.004, 0.006, 0.008, 0.01, 0.05, 0.1, 0.2, 0.4, 0.8, 1, 1.4, 2, 5, 10, 15}
+
+type (
+	// Option configures the Processor.
+	Option func(*Processor)
+
+	// Processor records the RED metrics of the ended spans:
+	//   - traces.span.metrics.calls: counter of the spans, by status.code for the error rate
+	//   - traces.span.metrics.duration: histogram of the span durations
+	//
+	// Both have the span.name, span.kind and status.code attributes, plus the dimensions
+	// copied from the span attributes. Span names must have a low cardinality, such as
+	// routes rather than raw paths.
+	//
+	// A Processor is safe for concurrent use.
+	Processor struct {
+		dimensions []attribute.Key
+		kinds      map[trace.SpanKind]struct{}
+
+		calls    metric.Int64Counter
+		duration metric.Float64Histogram
+	}
+)
+
+var _ sdktrace.SpanProcessor = (*Processor)(nil)
+
+// WithDimensions copies the given span attributes, when set, to the metrics attributes.
+// They must have a low cardinality, such as http.request.method or rpc.service.
+//
+// Parameters:
+//   - keys: The span attribute keys
+//
+// Returns:
+//   - An Option setting the dimensions
+func WithDimensions(keys ...attribute.Key) Option {
+	return func(p *Processor) {
+		p.dimensions = append(p.dimensions, keys...)
+	}
+}
+
+// WithSpanKinds restricts the metrics to the spans of the given kinds, such as
+// trace.SpanKindServer and trace.SpanKindConsumer for the entry points. All the spans
+// are recorded by default.
+//
+// Parameters:
+//   - kinds: The recorded span kinds
+//
+// Returns:
+//   - An Option setting the recorded span kinds
+func WithSpanKinds(kinds ...trace.SpanKind) Option {
+	return func(p *Processor) {
+		p.kinds = make(map[trace.SpanKind]struct{}, len(kinds))
+		for _, kind := range kinds {
+			p.kinds[kind] = struct{}{}
+		}
+	}
+}
+
+// NewProcessor creates a span metrics Processor and its instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//   - opts: Optional settings, such as the dimensions
+//
+// Returns:
+//   - The Processor, to be registered with sdktrace.WithSpanProcessor
+//   - An error if the meter instruments cannot be created
+func NewProcessor(meter metric.Meter, opts ...Option) (*Processor, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/spanmetrics")
+	}
+
+	p := &Processor{}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	calls, err := meter.Int64Counter("traces.span.metrics.calls", metric.WithDescription("Ended spans, by operation and status."), metric.WithUnit("{call}"))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("traces.span.metrics.duration",
+		metric.WithDescription("Duration of the ended spans, by operation and status."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.calls, p.duration = calls, duration
+
+	return p, nil
+}
+
+// OnStart does nothing, the spans are recorded when they end.
+func (p *Processor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd records the call and the duration of the ended span.
+func (p *Processor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if p.kinds != nil {
+		if _, ok := p.kinds[s.SpanKind()]; !ok {
+			return
+		}
+	}
+
+	attrs := make([]attribute.KeyValue, 0, 3+len(p.dimensions))
+	attrs = append(attrs,
+		attribute.String("span.name", s.Name()),
+		attribute.String("span.kind", s.SpanKind().String()),
+		attribute.String("status.code", statusCode(s.Status().Code)),
+	)
+	if len(p.dimensions) > 0 {
+		for _, kv := range s.Attributes() {
+			for _, key := range p.dimensions {
+				if kv.Key == key {
+					attrs = append(attrs, kv)
+					break
+				}
+			}
+		}
+	}
+
+	opt := metric.WithAttributes(attrs...)
+	ctx := context.Background()
+
+	p.calls.Add(ctx, 1, opt)
+	p.duration.Record(ctx, s.EndTime().Sub(s.StartTime()).Seconds(), opt)
+}
+
+// Shutdown does nothing, the metrics are exported by the MeterProvider.
+func (p *Processor) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush does nothing, the metrics are exported by the MeterProvider.
+func (p *Processor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// statusCode returns the status.code attribute value of a span status, named after
+// the spanmetrics connector values.
+func statusCode(code codes.Code) string {
+	switch code {
+	case codes.Error:
+		return "STATUS_CODE_ERROR"
+	case codes.Ok:
+		return "STATUS_CODE_OK"
+	default:
+		return "STATUS_CODE_UNSET"
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides cgroup CPU throttling metrics collection.
+package system
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"runtime"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// cgroupCPUStatPaths lists the cpu.stat locations of cgroup v2 and v1, in lookup order.
+var cgroupCPUStatPaths = []string{
+	"/sys/fs/cgroup/cpu.stat",
+	"/sys/fs/cgroup/cpu,cpuacct/cpu.stat",
+	"/sys/fs/cgroup/cpu/cpu.stat",
+}
+
+// NewCgroupCPUGauges creates a collector reporting the CFS throttling of the cgroup
+// the process runs in (throttled periods and time) alongside GOMAXPROCS, and a derived
+// throttling ratio, the share of CFS periods throttled since the previous collection.
+// CPU throttling is a hidden cause of many latency incidents, especially when
+// GOMAXPROCS exceeds the cgroup CPU quota.
+//
+// Outside of a cgroup with CPU bandwidth control only GOMAXPROCS is reported.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the instruments.
+//
+// Returns:
+//   - A BasicGauges implementation for cgroup CPU throttling collection.
+//   - An error if any instrument creation fails.
+func NewCgroupCPUGauges(meter metric.Meter) (BasicGauges, error) {
+	ctThrottledPeriods, err := meter.Int64ObservableCounter("cpu_cfs_throttled_periods_total", metric.WithDescription("Number of CFS periods in which the cgroup was throttled."))
+	if err != nil {
+		return nil, err
+	}
+
+	ctThrottledTime, err := meter.Float64ObservableCounter("cpu_cfs_throttled_seconds_total", metric.WithDescription("Total time the cgroup was throttled."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	ggThrottlingRatio, err := meter.Float64ObservableGauge("cpu_throttling_ratio", metric.WithDescription("Share of CFS periods throttled since the previous collection."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	ggGoMaxProcs, err := meter.Int64ObservableGauge("go_gomaxprocs", metric.WithDescription("Current GOMAXPROCS value."))
+	if err != nil {
+		return nil, err
+	}
+
+	g := &cgroupCPUGauges{
+		ctThrottledPeriods: ctThrottledPeriods,
+		ctThrottledTime:    ctThrottledTime,
+		ggThrottlingRatio:  ggThrottlingRatio,
+		ggGoMaxProcs:       ggGoMaxProcs,
+	}
+
+	for _, path := range cgroupCPUStatPaths {
+		if _, err := os.Stat(path); err == nil {
+			g.statPath = path
+			break
+		}
+	}
+
+	return g, nil
+}
+
+// Collect registers the callback reading the cgroup cpu.stat file on every collection.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (g *cgroupCPUGauges) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		observer.ObserveInt64(g.ggGoMaxProcs, int64(runtime.GOMAXPROCS(0)))
+
+		if g.statPath == "" {
+			return nil
+		}
+
+		stat, err := readCgroupCPUStat(g.statPath)
+		if err != nil {
+			return err
+		}
+
+		observer.ObserveInt64(g.ctThrottledPeriods, stat.throttledPeriods)
+		observer.ObserveFloat64(g.ctThrottledTime, stat.throttledTime.Seconds())
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		if prev := g.previous; prev != nil && stat.periods > prev.periods {
+			ratio := float64(stat.throttledPeriods-prev.throttledPeriods) / float64(stat.periods-prev.periods)
+			observer.ObserveFloat64(g.ggThrottlingRatio, ratio)
+		}
+		g.previous = stat
+
+		return nil
+	}
+
+	_, err := meter.RegisterCallback(cb, g.ctThrottledPeriods, g.ctThrottledTime, g.ggThrottlingRatio, g.ggGoMaxProcs)
+	return err
+}
+
+// readCgroupCPUStat parses a cgroup cpu.stat file. cgroup v2 reports the throttled
+// time in microseconds (throttled_usec) while cgroup v1 reports it in nanoseconds
+// (throttled_time).
+func readCgroupCPUStat(path string) (*cgroupCPUStat, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	stat := &cgroupCPUStat{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		fields := bytes.Fields(scanner.Bytes())
+		if len(fields) != 2 {
+			continue
+		}
+
+		v, err := strconv.ParseInt(string(fields[1]), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch string(fields[0]) {
+		case "nr_periods":
+			stat.periods = v
+		case "nr_throttled":
+			stat.throttledPeriods = v
+		case "throttled_usec":
+			stat.throttledTime = time.Duration(v) * time.Microsecond
+		case "throttled_time":
+			stat.throttledTime = time.Duration(v)
+		}
+	}
+
+	return stat, scanner.Err()
+}
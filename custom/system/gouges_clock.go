@@ -0,0 +1,199 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides the local clock offset gauge measured with NTP.
+package system
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultNTPServer           = "pool.ntp.org:123"
+	defaultClockOffsetInterval = 5 * time.Minute
+	defaultClockOffsetTimeout  = 5 * time.Second
+
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch (1970).
+	ntpEpochOffset = 2208988800
+
+	// ntpModeServer is the mode of the NTP server responses.
+	ntpModeServer = 4
+	// ntpLeapUnsynchronized is the leap indicator of an unsynchronized server clock.
+	ntpLeapUnsynchronized = 3
+)
+
+// errInvalidNTPResponse is returned when the NTP response can't be used to measure the offset.
+var errInvalidNTPResponse = errors.New("invalid NTP response")
+
+// NewClockOffsetGauge creates an optional collector that measures the offset of the
+// local clock against an NTP server and exports it in seconds. A positive value
+// means the local clock is behind the server. Skewed clocks silently corrupt
+// latency and rate computations, so alerting on this gauge is recommended.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the gauge instrument.
+//   - cfg: The NTP server, measurement interval and timeout.
+//
+// Returns:
+//   - A BasicGauges implementation reporting the clock offset, also implementing
+//     Stopper to end the measurement loop.
+//   - An error if the gauge creation fails.
+func NewClockOffsetGauge(meter metric.Meter, cfg ClockOffsetConfig) (BasicGauges, error) {
+	ggOffset, err := meter.Float64ObservableGauge("clock_offset_seconds", metric.WithDescription("Offset of the local clock against the NTP server."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Server == "" {
+		cfg.Server = defaultNTPServer
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultClockOffsetInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultClockOffsetTimeout
+	}
+
+	return &clockOffsetGauge{ggOffset: ggOffset, cfg: cfg}, nil
+}
+
+// Collect registers the callback reporting the last measured offset and starts the
+// background measurement loop, which runs until Stop is called. Nothing is reported
+// until a measurement succeeds.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (c *clockOffsetGauge) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		if c.offset != nil {
+			observer.ObserveFloat64(c.ggOffset, *c.offset)
+		}
+		return nil
+	}
+
+	return c.loop.start(func() (metric.Registration, error) {
+		return meter.RegisterCallback(cb, c.ggOffset)
+	}, c.measureLoop)
+}
+
+// Stop ends the measurement loop and unregisters the callback.
+func (c *clockOffsetGauge) Stop() {
+	c.loop.Stop()
+}
+
+// measureLoop measures the clock offset every interval, until stop is closed.
+func (c *clockOffsetGauge) measureLoop(stop <-chan struct{}) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if offset, err := ntpOffset(c.cfg.Server, c.cfg.Timeout); err == nil {
+			seconds := offset.Seconds()
+			c.mu.Lock()
+			c.offset = &seconds
+			c.mu.Unlock()
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ntpOffset queries the NTP server with a SNTP client request and computes the
+// local clock offset as ((t2 - t1) + (t3 - t4)) / 2. Responses that aren't a valid
+// answer to the request, such as a Kiss-o'-Death or an unsynchronized server, are
+// rejected with an error wrapping errInvalidNTPResponse.
+func ntpOffset(server string, timeout time.Duration) (time.Duration, error) {
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	// Leap indicator 0, version 4, client mode. The transmit timestamp is echoed by
+	// the server in the originate timestamp of the response.
+	req := make([]byte, 48)
+	req[0] = 0x23
+
+	t1 := time.Now()
+	putNTPTime(req[40:48], t1)
+	if _, err := conn.Write(req); err != nil {
+		return 0, err
+	}
+
+	resp := make([]byte, 48)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return 0, err
+	}
+	t4 := time.Now()
+
+	if err := validateNTPResponse(req, resp[:n]); err != nil {
+		return 0, err
+	}
+
+	t2 := ntpTime(resp[32:40]) // server receive timestamp
+	t3 := ntpTime(resp[40:48]) // server transmit timestamp
+
+	return (t2.Sub(t1) + t3.Sub(t4)) / 2, nil
+}
+
+// validateNTPResponse checks that resp is a server response to req from a synchronized
+// server carrying its timestamps.
+func validateNTPResponse(req, resp []byte) error {
+	if len(resp) < 48 {
+		return fmt.Errorf("%w: %d bytes", errInvalidNTPResponse, len(resp))
+	}
+
+	leap, mode, stratum := resp[0]>>6, resp[0]&0x7, resp[1]
+	switch {
+	case mode != ntpModeServer:
+		return fmt.Errorf("%w: mode %d", errInvalidNTPResponse, mode)
+	case leap == ntpLeapUnsynchronized:
+		return fmt.Errorf("%w: unsynchronized server", errInvalidNTPResponse)
+	case stratum == 0:
+		return fmt.Errorf("%w: kiss-o'-death %q", errInvalidNTPResponse, resp[12:16])
+	case binary.BigEndian.Uint64(resp[40:48]) == 0:
+		return fmt.Errorf("%w: zero transmit timestamp", errInvalidNTPResponse)
+	case !bytes.Equal(resp[24:32], req[40:48]):
+		return fmt.Errorf("%w: originate timestamp mismatch", errInvalidNTPResponse)
+	}
+
+	return nil
+}
+
+// ntpTime converts a 64 bits NTP timestamp to time.Time.
+func ntpTime(b []byte) time.Time {
+	seconds := int64(binary.BigEndian.Uint32(b[0:4])) - ntpEpochOffset
+	fraction := int64(binary.BigEndian.Uint32(b[4:8]))
+
+	return time.Unix(seconds, (fraction*int64(time.Second))>>32)
+}
+
+// putNTPTime writes t as a 64 bits NTP timestamp to b.
+func putNTPTime(b []byte, t time.Time) {
+	binary.BigEndian.PutUint32(b[0:4], uint32(t.Unix()+ntpEpochOffset))
+	binary.BigEndian.PutUint32(b[4:8], uint32((int64(t.Nanosecond())<<32)/int64(time.Second)))
+}
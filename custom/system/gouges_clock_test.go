@@ -0,0 +1,108 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// serveNTP answers one NTP request on a local UDP socket with the response built by
+// reply from the request, and returns the server address.
+func serveNTP(t *testing.T, reply func(req []byte) []byte) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		req := make([]byte, 48)
+		n, addr, err := conn.ReadFrom(req)
+		if err != nil {
+			return
+		}
+		_, _ = conn.WriteTo(reply(req[:n]), addr)
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// ntpResponse builds a synchronized server response to req with the clock of the server
+// ahead of the local clock by skew.
+func ntpResponse(req []byte, skew time.Duration) []byte {
+	resp := make([]byte, 48)
+	resp[0] = 0x24 // Leap indicator 0, version 4, server mode
+	resp[1] = 2    // Stratum
+	copy(resp[24:32], req[40:48])
+	now := time.Now().Add(skew)
+	putNTPTime(resp[32:40], now)
+	putNTPTime(resp[40:48], now)
+	return resp
+}
+
+// TestNTPOffset verifies the offset measured against a valid server response.
+func TestNTPOffset(t *testing.T) {
+	server := serveNTP(t, func(req []byte) []byte { return ntpResponse(req, time.Hour) })
+
+	offset, err := ntpOffset(server, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := offset - time.Hour; diff < -time.Second || diff > time.Second {
+		t.Errorf("got offset %s, want about 1h", offset)
+	}
+}
+
+// TestNTPOffsetInvalidResponses verifies that the responses that can't be used to
+// measure the offset are rejected instead of producing a bogus offset.
+func TestNTPOffsetInvalidResponses(t *testing.T) {
+	tests := map[string]func(req []byte) []byte{
+		"short": func(req []byte) []byte {
+			return ntpResponse(req, 0)[:40]
+		},
+		"client mode": func(req []byte) []byte {
+			resp := ntpResponse(req, 0)
+			resp[0] = 0x23
+			return resp
+		},
+		"unsynchronized": func(req []byte) []byte {
+			resp := ntpResponse(req, 0)
+			resp[0] |= ntpLeapUnsynchronized << 6
+			return resp
+		},
+		"kiss-o'-death": func(req []byte) []byte {
+			resp := make([]byte, 48)
+			resp[0] = 0x24
+			copy(resp[12:16], "RATE")
+			copy(resp[24:32], req[40:48])
+			return resp
+		},
+		"zero transmit timestamp": func(req []byte) []byte {
+			resp := ntpResponse(req, 0)
+			clear(resp[40:48])
+			return resp
+		},
+		"originate mismatch": func(req []byte) []byte {
+			resp := ntpResponse(req, 0)
+			resp[31] ^= 0xff
+			return resp
+		},
+	}
+
+	for name, reply := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := serveNTP(t, reply)
+
+			if offset, err := ntpOffset(server, time.Second); !errors.Is(err, errInvalidNTPResponse) {
+				t.Errorf("got offset %s and error %v, want errInvalidNTPResponse", offset, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides an experimental CPU usage breakdown based on periodic CPU profiles.
+package system
+
+import (
+	"bytes"
+	"context"
+	"runtime/pprof"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultCPUProfileInterval = time.Minute
+	defaultCPUProfileDuration = 5 * time.Second
+	defaultCPUProfileTopN     = 10
+)
+
+// NewCPUProfileGauges creates an experimental collector that runs short CPU profiles
+// periodically and exports the CPU share of the top-N functions as gauges, giving a
+// lightweight continuous profiling view inside the metrics pipeline.
+//
+// The share is computed from the self time of each function in the last profile.
+// A profile is skipped when another CPU profile is already running in the process,
+// for instance one started through net/http/pprof.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create gauge instruments.
+//   - cfg: The profiling interval, duration and number of functions to export.
+//
+// Returns:
+//   - A BasicGauges implementation for CPU usage breakdown collection, also
+//     implementing Stopper to end the profiling loop.
+//   - An error if the gauge creation fails.
+func NewCPUProfileGauges(meter metric.Meter, cfg CPUProfileConfig) (BasicGauges, error) {
+	ggCPUShare, err := meter.Float64ObservableGauge("go_cpu_profile_function_share", metric.WithDescription("Share of sampled CPU time spent in the function during the last profile."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultCPUProfileInterval
+	}
+	if cfg.Duration <= 0 || cfg.Duration > cfg.Interval {
+		cfg.Duration = min(defaultCPUProfileDuration, cfg.Interval)
+	}
+	if cfg.TopN <= 0 {
+		cfg.TopN = defaultCPUProfileTopN
+	}
+
+	return &cpuProfileGauges{ggCPUShare: ggCPUShare, cfg: cfg}, nil
+}
+
+// Collect registers the callback that reports the top functions of the last
+// completed profile and starts the background profiling loop, which runs until
+// Stop is called.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (c *cpuProfileGauges) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		for _, fn := range c.top {
+			observer.ObserveFloat64(c.ggCPUShare, fn.share, metric.WithAttributes(attribute.String("function", fn.name)))
+		}
+
+		return nil
+	}
+
+	return c.loop.start(func() (metric.Registration, error) {
+		return meter.RegisterCallback(cb, c.ggCPUShare)
+	}, c.profileLoop)
+}
+
+// Stop ends the profiling loop, interrupting the running profile, and unregisters
+// the callback.
+func (c *cpuProfileGauges) Stop() {
+	c.loop.Stop()
+}
+
+// profileLoop runs a CPU profile every interval and stores its top functions,
+// until stop is closed.
+func (c *cpuProfileGauges) profileLoop(stop <-chan struct{}) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if top, err := c.profile(stop); err == nil {
+			c.mu.Lock()
+			c.top = top
+			c.mu.Unlock()
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// profile runs a single CPU profile and returns the top-N functions by self time.
+// The profile is cut short when stop is closed.
+func (c *cpuProfileGauges) profile(stop <-chan struct{}) ([]functionShare, error) {
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		return nil, err
+	}
+	wait(stop, c.cfg.Duration)
+	pprof.StopCPUProfile()
+
+	selfTime, err := parseCPUProfile(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	top := make([]functionShare, 0, len(selfTime))
+	for name, v := range selfTime {
+		total += v
+		top = append(top, functionShare{name: name, share: float64(v)})
+	}
+	if total == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].share == top[j].share {
+			return top[i].name < top[j].name
+		}
+		return top[i].share > top[j].share
+	})
+	if len(top) > c.cfg.TopN {
+		top = top[:c.cfg.TopN]
+	}
+	for i := range top {
+		top[i].share /= float64(total)
+	}
+
+	return top, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides an experimental GC tuning advisor reported as gauges.
+package system
+
+import (
+	"context"
+	"runtime/debug"
+	runtimemetrics "runtime/metrics"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	rmGCGoal   = "/gc/heap/goal:bytes"
+	rmGCLive   = "/gc/heap/live:bytes"
+	rmGOGC     = "/gc/gogc:percent"
+	rmGCCPU    = "/cpu/classes/gc/total:cpu-seconds"
+	rmTotalCPU = "/cpu/classes/total:cpu-seconds"
+
+	// gcCPUTargetLow and gcCPUTargetHigh bound the share of CPU time the GC is
+	// expected to use. The suggested GOGC range brings the GC back into this band.
+	gcCPUTargetLow  = 0.02
+	gcCPUTargetHigh = 0.05
+
+	minSuggestedGOGC = 25
+	maxSuggestedGOGC = 1000
+)
+
+// NewGCAdvisorGauges creates an experimental collector that observes the GC
+// frequency, pause time, CPU cost and heap goal between two collections and
+// exports advisory gauges, including a suggested GOGC range, so tuning hints
+// show up directly in dashboards.
+//
+// The suggestion assumes the GC CPU cost is inversely proportional to GOGC and
+// scales the current value so that the GC uses between 2% and 5% of the CPU time.
+// It is a hint, not a guarantee: a memory limit or a bursty allocation pattern
+// can make a different value preferable. Nothing is suggested when GC is off.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create gauge instruments.
+//
+// Returns:
+//   - A BasicGauges implementation reporting the GC advisory gauges.
+//   - An error if any gauge creation fails.
+func NewGCAdvisorGauges(meter metric.Meter) (BasicGauges, error) {
+	ggCyclesPerSecond, err := meter.Float64ObservableGauge("go_gc_advisor_cycles_per_second", metric.WithDescription("GC cycles per second since the last collection."), metric.WithUnit("1/s"))
+	if err != nil {
+		return nil, err
+	}
+
+	ggPauseRatio, err := meter.Float64ObservableGauge("go_gc_advisor_pause_ratio", metric.WithDescription("Share of wall time spent in GC pauses since the last collection."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	ggCPUFraction, err := meter.Float64ObservableGauge("go_gc_advisor_cpu_fraction", metric.WithDescription("Share of CPU time spent in the GC since the last collection."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	ggHeapGoalRatio, err := meter.Float64ObservableGauge("go_gc_advisor_heap_goal_ratio", metric.WithDescription("Heap goal over live heap after the last GC."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	ggSuggestedGOGC, err := meter.Int64ObservableGauge("go_gc_advisor_gogc_suggested", metric.WithDescription("Bounds of the suggested GOGC range, by bound attribute."), metric.WithUnit("%"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcAdvisorGauges{
+		ggCyclesPerSecond: ggCyclesPerSecond,
+		ggPauseRatio:      ggPauseRatio,
+		ggCPUFraction:     ggCPUFraction,
+		ggHeapGoalRatio:   ggHeapGoalRatio,
+		ggSuggestedGOGC:   ggSuggestedGOGC,
+		samples: []runtimemetrics.Sample{
+			{Name: rmGCGoal},
+			{Name: rmGCLive},
+			{Name: rmGOGC},
+			{Name: rmGCCPU},
+			{Name: rmTotalCPU},
+			{Name: rmGCCycles},
+		},
+	}, nil
+}
+
+// Collect registers the callback computing the advisory gauges. Rates and
+// suggestions are computed against the previous collection, so they are only
+// reported from the second collection onward.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (g *gcAdvisorGauges) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		runtimemetrics.Read(g.samples)
+		goal, live, gogc := sampleInt64(g.samples[0]), sampleInt64(g.samples[1]), sampleInt64(g.samples[2])
+
+		var stats debug.GCStats
+		debug.ReadGCStats(&stats)
+
+		current := &gcSnapshot{
+			at:       time.Now(),
+			cycles:   sampleInt64(g.samples[5]),
+			pause:    stats.PauseTotal,
+			gcCPU:    sampleFloat64(g.samples[3]),
+			totalCPU: sampleFloat64(g.samples[4]),
+		}
+		previous := g.previous
+		g.previous = current
+
+		if live > 0 {
+			observer.ObserveFloat64(g.ggHeapGoalRatio, float64(goal)/float64(live))
+		}
+
+		if previous == nil {
+			return nil
+		}
+
+		elapsed := current.at.Sub(previous.at)
+		if elapsed <= 0 {
+			return nil
+		}
+		observer.ObserveFloat64(g.ggCyclesPerSecond, float64(current.cycles-previous.cycles)/elapsed.Seconds())
+		observer.ObserveFloat64(g.ggPauseRatio, float64(current.pause-previous.pause)/float64(elapsed))
+
+		cpu := current.totalCPU - previous.totalCPU
+		if cpu <= 0 {
+			return nil
+		}
+		fraction := (current.gcCPU - previous.gcCPU) / cpu
+		observer.ObserveFloat64(g.ggCPUFraction, fraction)
+
+		if gogc <= 0 || current.cycles == previous.cycles {
+			return nil
+		}
+		low, high := suggestGOGC(gogc, fraction)
+		observer.ObserveInt64(g.ggSuggestedGOGC, low, metric.WithAttributes(attribute.String("bound", "min")))
+		observer.ObserveInt64(g.ggSuggestedGOGC, high, metric.WithAttributes(attribute.String("bound", "max")))
+
+		return nil
+	}
+
+	_, err := meter.RegisterCallback(cb, g.ggCyclesPerSecond, g.ggPauseRatio, g.ggCPUFraction, g.ggHeapGoalRatio, g.ggSuggestedGOGC)
+	return err
+}
+
+// suggestGOGC scales the current GOGC so that the GC CPU fraction falls between
+// gcCPUTargetLow and gcCPUTargetHigh, clamped to a sensible range.
+func suggestGOGC(gogc int64, fraction float64) (low, high int64) {
+	clamp := func(v float64) int64 {
+		return int64(min(max(v, minSuggestedGOGC), maxSuggestedGOGC))
+	}
+
+	return clamp(float64(gogc) * fraction / gcCPUTargetHigh), clamp(float64(gogc) * fraction / gcCPUTargetLow)
+}
+
+// sampleFloat64 returns the value of a runtime/metrics sample as float64,
+// or zero if the metric is not supported by the running Go version.
+func sampleFloat64(s runtimemetrics.Sample) float64 {
+	if s.Value.Kind() != runtimemetrics.KindFloat64 {
+		return 0
+	}
+	return s.Value.Float64()
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides goroutine state metrics collection based on the goroutine profile.
+package system
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultGoroutineStateInterval is the default sampling interval of the goroutine
+// state collector.
+const DefaultGoroutineStateInterval = 15 * time.Second
+
+// Goroutine states reported by the goroutine state collector.
+// States not listed here are reported as GoroutineStateOther.
+const (
+	GoroutineStateRunning     = "running"
+	GoroutineStateRunnable    = "runnable"
+	GoroutineStateChanReceive = "chan receive"
+	GoroutineStateChanSend    = "chan send"
+	GoroutineStateSelect      = "select"
+	GoroutineStateIOWait      = "IO wait"
+	GoroutineStateSemacquire  = "semacquire"
+	GoroutineStateSyncWait    = "sync wait"
+	GoroutineStateSleep       = "sleep"
+	GoroutineStateSyscall     = "syscall"
+	GoroutineStateOther       = "other"
+)
+
+// goroutineStates lists the reported states in a stable order.
+var goroutineStates = []string{
+	GoroutineStateRunning,
+	GoroutineStateRunnable,
+	GoroutineStateChanReceive,
+	GoroutineStateChanSend,
+	GoroutineStateSelect,
+	GoroutineStateIOWait,
+	GoroutineStateSemacquire,
+	GoroutineStateSyncWait,
+	GoroutineStateSleep,
+	GoroutineStateSyscall,
+	GoroutineStateOther,
+}
+
+// NewGoroutineStateGauges creates an optional collector that exports the number of
+// goroutines grouped by their state (running, chan receive, select, IO wait,
+// semacquire, ...). Unlike go_goroutines, it shows what the process is waiting on.
+//
+// The goroutine profile, which briefly stops the world, is captured in the background
+// every interval rather than on every collection, which reports the counts of the last
+// profile. This collector is intended for services with moderate goroutine counts.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create gauge instruments.
+//   - interval: The time between two goroutine profiles, DefaultGoroutineStateInterval if zero.
+//
+// Returns:
+//   - A BasicGauges implementation for goroutine state collection, also implementing
+//     Stopper to end the sampling loop.
+//   - An error if the gauge creation fails.
+func NewGoroutineStateGauges(meter metric.Meter, interval time.Duration) (BasicGauges, error) {
+	ggGoroutinesByState, err := meter.Int64ObservableGauge("go_goroutines_by_state", metric.WithDescription("Number of goroutines by wait state."))
+	if err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = DefaultGoroutineStateInterval
+	}
+
+	return &goroutineStateGauges{ggGoroutinesByState: ggGoroutinesByState, interval: interval}, nil
+}
+
+// Collect captures a first goroutine profile, registers the callback reporting the
+// goroutine count for each state of the last profile and starts the background
+// sampling loop, which runs until Stop is called.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (g *goroutineStateGauges) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		g.mu.RLock()
+		defer g.mu.RUnlock()
+
+		if g.counts == nil {
+			return nil
+		}
+		for _, state := range goroutineStates {
+			observer.ObserveInt64(g.ggGoroutinesByState, g.counts[state], metric.WithAttributes(attribute.String("state", state)))
+		}
+
+		return nil
+	}
+
+	g.sample()
+
+	return g.loop.start(func() (metric.Registration, error) {
+		return meter.RegisterCallback(cb, g.ggGoroutinesByState)
+	}, g.sampleLoop)
+}
+
+// Stop ends the sampling loop and unregisters the callback.
+func (g *goroutineStateGauges) Stop() {
+	g.loop.Stop()
+}
+
+// sampleLoop captures the goroutine profile every interval, until stop is closed.
+func (g *goroutineStateGauges) sampleLoop(stop <-chan struct{}) {
+	for wait(stop, g.interval) {
+		g.sample()
+	}
+}
+
+// sample captures the goroutine profile and stores the counts by state, keeping the
+// previous counts if the profile can't be captured.
+func (g *goroutineStateGauges) sample() {
+	counts, err := goroutineStateCounts()
+	if err != nil {
+		return
+	}
+
+	g.mu.Lock()
+	g.counts = counts
+	g.mu.Unlock()
+}
+
+// goroutineStateCounts captures the goroutine profile in its text form and counts
+// the goroutines by normalized state. Each goroutine starts with a header line
+// like "goroutine 7 [chan receive, 5 minutes]:".
+func goroutineStateCounts() (map[string]int64, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 2); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(goroutineStates))
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "goroutine ") {
+			continue
+		}
+
+		start := strings.IndexByte(line, '[')
+		end := strings.IndexByte(line, ']')
+		if start < 0 || end < start {
+			continue
+		}
+
+		counts[normalizeGoroutineState(line[start+1:end])]++
+	}
+
+	return counts, scanner.Err()
+}
+
+// normalizeGoroutineState maps the raw state of the goroutine profile, which may
+// contain wait durations and qualifiers, to one of the reported states.
+func normalizeGoroutineState(raw string) string {
+	state, _, _ := strings.Cut(raw, ",")
+	state = strings.TrimSpace(state)
+
+	switch {
+	case state == GoroutineStateRunning:
+		return GoroutineStateRunning
+	case state == GoroutineStateRunnable:
+		return GoroutineStateRunnable
+	case strings.HasPrefix(state, GoroutineStateChanReceive):
+		return GoroutineStateChanReceive
+	case strings.HasPrefix(state, GoroutineStateChanSend):
+		return GoroutineStateChanSend
+	case strings.HasPrefix(state, GoroutineStateSelect):
+		return GoroutineStateSelect
+	case state == GoroutineStateIOWait:
+		return GoroutineStateIOWait
+	case strings.HasPrefix(state, GoroutineStateSemacquire):
+		return GoroutineStateSemacquire
+	case strings.HasPrefix(state, "sync."):
+		return GoroutineStateSyncWait
+	case state == GoroutineStateSleep:
+		return GoroutineStateSleep
+	case state == GoroutineStateSyscall:
+		return GoroutineStateSyscall
+	default:
+		return GoroutineStateOther
+	}
+}
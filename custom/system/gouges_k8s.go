@@ -0,0 +1,63 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides the Kubernetes pod restart count gauge.
+package system
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// NewPodRestartGauge creates a gauge reporting the restart count of the pod read from
+// a mounted status file, which holds the count as a plain integer. It is usually
+// written by an init container or projected from the pod status by the platform.
+// Nothing is reported while the file is missing or invalid.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the gauge instrument.
+//   - path: The path of the file holding the restart count.
+//
+// Returns:
+//   - A BasicGauges implementation reporting the pod restart count.
+//   - An error if the gauge creation fails.
+func NewPodRestartGauge(meter metric.Meter, path string) (BasicGauges, error) {
+	ggRestarts, err := meter.Int64ObservableGauge("k8s.pod.restart_count", metric.WithDescription("Number of times the pod containers were restarted."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &podRestartGauge{ggRestarts, path}, nil
+}
+
+// Collect registers the callback reading the restart count file on every collection.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (p *podRestartGauge) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		b, err := os.ReadFile(p.path)
+		if err != nil {
+			return nil
+		}
+
+		count, err := strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		observer.ObserveInt64(p.ggRestarts, count)
+		return nil
+	}
+
+	_, err := meter.RegisterCallback(cb, p.ggRestarts)
+	return err
+}
@@ -167,7 +167,10 @@ func NewMemGauges(meter metric.Meter) (BasicGauges, error) {
 //
 // Parameters:
 //   - meter: The OpenTelemetry meter used to register callbacks.
-func (m *memGauges) Collect(meter metric.Meter) {
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (m *memGauges) Collect(meter metric.Meter) error {
 	// Define a callback function that will be called periodically to collect metrics
 	cb := func(_ context.Context, observer metric.Observer) error {
 		// Retrieve the current memory statistics from the Go runtime
@@ -202,6 +205,29 @@ func (m *memGauges) Collect(meter metric.Meter) {
 	}
 
 	// Register the callback with the meter
-	// We ignore the returned registration to avoid verbosity
-	_, _ = meter.RegisterCallback(cb)
+	_, err := meter.RegisterCallback(cb,
+		m.ggSysBytes,
+		m.ggAllocBytesTotal,
+		m.ggHeapAllocBytes,
+		m.ggFreesTotal,
+		m.ggGcSysBytes,
+		m.ggHeapIdleBytes,
+		m.ggInuseBytes,
+		m.ggHeapObjects,
+		m.ggHeapReleasedBytes,
+		m.ggHeapSysBytes,
+		m.ggLastGcTimeSeconds,
+		m.ggLookupsTotal,
+		m.ggMallocsTotal,
+		m.ggMCacheInuseBytes,
+		m.ggMCacheSysBytes,
+		m.ggMspanInuseBytes,
+		m.ggMspanSysBytes,
+		m.ggNextGcBytes,
+		m.ggOtherSysBytes,
+		m.ggStackInuseBytes,
+		m.ggGcCompletedCycle,
+		m.ggGcPauseTotal,
+	)
+	return err
 }
@@ -0,0 +1,107 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides a low-overhead runtime metrics collector for constrained devices.
+package system
+
+import (
+	"context"
+	runtimemetrics "runtime/metrics"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultMinimalInterval is the default refresh interval of the minimal collector.
+const DefaultMinimalInterval = time.Minute
+
+// runtime/metrics keys read by the minimal collector.
+const (
+	rmGoroutines = "/sched/goroutines:goroutines"
+	rmHeapObject = "/memory/classes/heap/objects:bytes"
+	rmGCCycles   = "/gc/cycles/total:gc-cycles"
+)
+
+// NewMinimalGauges creates a low-overhead collector registering only the goroutine
+// count, the heap allocation and the GC count, using the same names as the full
+// collectors. It is meant for resource-constrained edge devices where reading the
+// full memstats set on every collection is too costly.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create gauge instruments.
+//   - interval: The minimum time between two runtime reads, DefaultMinimalInterval if zero.
+//
+// Returns:
+//   - A BasicGauges implementation for minimal runtime metrics collection.
+//   - An error if any gauge creation fails.
+func NewMinimalGauges(meter metric.Meter, interval time.Duration) (BasicGauges, error) {
+	ggGRoutines, err := meter.Int64ObservableGauge("go_goroutines", metric.WithDescription("Number of goroutines."))
+	if err != nil {
+		return nil, err
+	}
+
+	ggHeapAllocBytes, err := meter.Int64ObservableGauge("go_memstats_heap_alloc_bytes", metric.WithDescription("Number of heap bytes allocated and still in use."))
+	if err != nil {
+		return nil, err
+	}
+
+	ggGcCompletedCycle, err := meter.Int64ObservableGauge("go_memstats_gc_completed_cycle", metric.WithDescription("Number of GC cycle completed."))
+	if err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = DefaultMinimalInterval
+	}
+
+	return &minimalGauges{
+		ggGRoutines:        ggGRoutines,
+		ggHeapAllocBytes:   ggHeapAllocBytes,
+		ggGcCompletedCycle: ggGcCompletedCycle,
+		interval:           interval,
+		samples: []runtimemetrics.Sample{
+			{Name: rmGoroutines},
+			{Name: rmHeapObject},
+			{Name: rmGCCycles},
+		},
+	}, nil
+}
+
+// Collect registers the callback reporting the minimal runtime metrics.
+// Between two refreshes the last read values are reported again.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (m *minimalGauges) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if now := time.Now(); now.Sub(m.last) >= m.interval {
+			runtimemetrics.Read(m.samples)
+			m.last = now
+		}
+
+		observer.ObserveInt64(m.ggGRoutines, sampleInt64(m.samples[0]))
+		observer.ObserveInt64(m.ggHeapAllocBytes, sampleInt64(m.samples[1]))
+		observer.ObserveInt64(m.ggGcCompletedCycle, sampleInt64(m.samples[2]))
+
+		return nil
+	}
+
+	_, err := meter.RegisterCallback(cb, m.ggGRoutines, m.ggHeapAllocBytes, m.ggGcCompletedCycle)
+	return err
+}
+
+// sampleInt64 returns the value of a runtime/metrics sample as int64,
+// or zero if the metric is not supported by the running Go version.
+func sampleInt64(s runtimemetrics.Sample) int64 {
+	if s.Value.Kind() != runtimemetrics.KindUint64 {
+		return 0
+	}
+	return int64(s.Value.Uint64())
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides process level metrics collection with platform specific implementations.
+package system
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// SupportedProcessMetrics reports which process metrics the current platform supports.
+// Linux reports file descriptors and resident memory, while Windows reports handles
+// and the working set; other platforms report no process metrics.
+//
+// Returns:
+//   - The process metrics capabilities of the current platform.
+func SupportedProcessMetrics() ProcessCapabilities {
+	return processCapabilities
+}
+
+// NewProcessGauges creates a new process metrics collector that monitors CPU time,
+// memory usage and open descriptors of the current process. Only the instruments
+// supported by the current platform are created.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the instruments.
+//
+// Returns:
+//   - A BasicGauges implementation for process metrics collection.
+//   - An error if any instrument creation fails.
+func NewProcessGauges(meter metric.Meter) (BasicGauges, error) {
+	var err error
+	p := &processGauges{capabilities: processCapabilities}
+
+	if p.capabilities.CPUTime {
+		p.ctCPUSeconds, err = meter.Float64ObservableCounter("process_cpu_seconds_total", metric.WithDescription("Total user and system CPU time spent in seconds."), metric.WithUnit("s"))
+		if err != nil {
+			return nil, err
+		}
+		p.observables = append(p.observables, p.ctCPUSeconds)
+	}
+
+	if p.capabilities.ResidentMemory {
+		p.ggMemoryBytes, err = meter.Int64ObservableGauge("process_resident_memory_bytes", metric.WithDescription("Resident memory size in bytes, working set size on Windows."), metric.WithUnit("By"))
+		if err != nil {
+			return nil, err
+		}
+		p.observables = append(p.observables, p.ggMemoryBytes)
+	}
+
+	if p.capabilities.OpenFDs {
+		p.ggOpenFDs, err = meter.Int64ObservableGauge("process_open_fds", metric.WithDescription("Number of open file descriptors."))
+		if err != nil {
+			return nil, err
+		}
+		p.observables = append(p.observables, p.ggOpenFDs)
+	}
+
+	if p.capabilities.MaxFDs {
+		p.ggMaxFDs, err = meter.Int64ObservableGauge("process_max_fds", metric.WithDescription("Maximum number of open file descriptors."))
+		if err != nil {
+			return nil, err
+		}
+		p.observables = append(p.observables, p.ggMaxFDs)
+	}
+
+	if p.capabilities.OpenHandles {
+		p.ggOpenHandles, err = meter.Int64ObservableGauge("process_open_handles", metric.WithDescription("Number of open handles."))
+		if err != nil {
+			return nil, err
+		}
+		p.observables = append(p.observables, p.ggOpenHandles)
+	}
+
+	return p, nil
+}
+
+// Collect registers callbacks for process metrics collection.
+// It reads the process statistics from the operating system on every collection
+// and reports the ones supported by the current platform.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (p *processGauges) Collect(meter metric.Meter) error {
+	if len(p.observables) == 0 {
+		return nil
+	}
+
+	cb := func(_ context.Context, observer metric.Observer) error {
+		stats, err := readProcessStats()
+		if err != nil {
+			return err
+		}
+
+		if p.capabilities.CPUTime {
+			observer.ObserveFloat64(p.ctCPUSeconds, stats.cpuSeconds)
+		}
+		if p.capabilities.ResidentMemory {
+			observer.ObserveInt64(p.ggMemoryBytes, stats.memoryBytes)
+		}
+		if p.capabilities.OpenFDs {
+			observer.ObserveInt64(p.ggOpenFDs, stats.openFDs)
+		}
+		if p.capabilities.MaxFDs {
+			observer.ObserveInt64(p.ggMaxFDs, stats.maxFDs)
+		}
+		if p.capabilities.OpenHandles {
+			observer.ObserveInt64(p.ggOpenHandles, stats.openHandles)
+		}
+
+		return nil
+	}
+
+	_, err := meter.RegisterCallback(cb, p.observables...)
+	return err
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides the database schema migration version gauges.
+package system
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// defaultSchemaVersionTimeout bounds the version read when no timeout is configured.
+const defaultSchemaVersionTimeout = 2 * time.Second
+
+// NewSchemaVersionGauges creates the db.schema.version gauge, reporting the current
+// schema migration version, and the db.schema.dirty gauge, 1 when a migration failed
+// midway, so fleet-wide schema drift is visible. Nothing is reported when the version
+// cannot be read.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the gauge instruments.
+//   - cfg: The database name and the function reading its version.
+//
+// Returns:
+//   - A BasicGauges implementation reporting the schema version.
+//   - An error if the configuration is invalid or the gauge creation fails.
+func NewSchemaVersionGauges(meter metric.Meter, cfg SchemaVersionConfig) (BasicGauges, error) {
+	if cfg.Version == nil {
+		return nil, errors.New("schema version function is required")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultSchemaVersionTimeout
+	}
+
+	ggVersion, err := meter.Int64ObservableGauge("db.schema.version", metric.WithDescription("Current schema migration version of the database."))
+	if err != nil {
+		return nil, err
+	}
+
+	ggDirty, err := meter.Int64ObservableGauge("db.schema.dirty", metric.WithDescription("Whether the last schema migration failed midway (1) or not (0)."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaVersionGauges{ggVersion, ggDirty, cfg}, nil
+}
+
+// Collect registers the callback reading the schema version on every collection.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (s *schemaVersionGauges) Collect(meter metric.Meter) error {
+	attrs := metric.WithAttributes(attribute.String("database", s.cfg.Database))
+
+	cb := func(ctx context.Context, observer metric.Observer) error {
+		ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+		defer cancel()
+
+		version, dirty, err := s.cfg.Version(ctx)
+		if err != nil {
+			return nil
+		}
+
+		var d int64
+		if dirty {
+			d = 1
+		}
+
+		observer.ObserveInt64(s.ggVersion, version, attrs)
+		observer.ObserveInt64(s.ggDirty, d, attrs)
+		return nil
+	}
+
+	_, err := meter.RegisterCallback(cb, s.ggVersion, s.ggDirty)
+	return err
+}
+
+// GooseVersion returns a SchemaVersionFunc reading the version of the last migration
+// applied by goose from its goose_db_version table. Goose schemas are never dirty.
+//
+// Parameters:
+//   - db: The database migrated by goose.
+//
+// Returns:
+//   - A SchemaVersionFunc reading the goose version.
+func GooseVersion(db *sql.DB) SchemaVersionFunc {
+	return func(ctx context.Context) (int64, bool, error) {
+		var version int64
+		err := db.QueryRowContext(ctx, "SELECT version_id FROM goose_db_version WHERE is_applied ORDER BY id DESC LIMIT 1").Scan(&version)
+		return version, false, err
+	}
+}
+
+// MigrateVersion returns a SchemaVersionFunc reading the version and dirty flag
+// stored by golang-migrate in its schema_migrations table.
+//
+// Parameters:
+//   - db: The database migrated by golang-migrate.
+//
+// Returns:
+//   - A SchemaVersionFunc reading the golang-migrate version.
+func MigrateVersion(db *sql.DB) SchemaVersionFunc {
+	return func(ctx context.Context) (int64, bool, error) {
+		var version int64
+		var dirty bool
+		err := db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+		return version, dirty, err
+	}
+}
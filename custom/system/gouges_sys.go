@@ -57,7 +57,10 @@ func NewSysGauge(meter metric.Meter) (BasicGauges, error) {
 //
 // Parameters:
 //   - meter: The OpenTelemetry meter used to register callbacks.
-func (s *sysGauges) Collect(meter metric.Meter) {
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (s *sysGauges) Collect(meter metric.Meter) error {
 	// Define the callback function for collecting system metrics
 	cb := func(_ context.Context, observer metric.Observer) error {
 		// Record the number of CPU cores available
@@ -73,5 +76,6 @@ func (s *sysGauges) Collect(meter metric.Meter) {
 	}
 
 	// Register the callback with the meter
-	_, _ = meter.RegisterCallback(cb)
+	_, err := meter.RegisterCallback(cb, s.ggThreads, s.ggCgo, s.ggGRoutines)
+	return err
 }
@@ -0,0 +1,149 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides memory watermark alarms reported as metrics.
+package system
+
+import (
+	"context"
+	"errors"
+	"math"
+	"runtime/debug"
+	runtimemetrics "runtime/metrics"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// MemoryPressureNormal is the pressure level below the soft watermark.
+	MemoryPressureNormal = "normal"
+	// MemoryPressureSoft is the pressure level between the soft and hard watermarks.
+	MemoryPressureSoft = "soft"
+	// MemoryPressureHard is the pressure level above the hard watermark.
+	MemoryPressureHard = "hard"
+
+	defaultWatermarkInterval = time.Second
+	defaultSoftWatermarkPct  = 0.80
+	defaultHardWatermarkPct  = 0.95
+
+	heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+)
+
+// memoryPressureLevels lists the pressure levels ordered by severity.
+var memoryPressureLevels = [...]string{MemoryPressureNormal, MemoryPressureSoft, MemoryPressureHard}
+
+// NewMemoryWatermarkGauges creates an optional collector that compares the heap
+// size against soft and hard watermarks. The memory_pressure_level state gauge
+// reports 1 for the current level and 0 for the others, and the
+// memory_watermark_breaches_total counter is incremented each time a watermark is
+// crossed upward. Both are meant to drive load shedding or autoscaling rules.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the instruments.
+//   - cfg: The soft and hard watermarks and the check interval.
+//
+// Returns:
+//   - A BasicGauges implementation reporting the memory pressure level, also
+//     implementing Stopper to end the check loop.
+//   - An error if no watermark can be derived or the instrument creation fails.
+func NewMemoryWatermarkGauges(meter metric.Meter, cfg MemoryWatermarkConfig) (BasicGauges, error) {
+	if limit := debug.SetMemoryLimit(-1); limit > 0 && limit < math.MaxInt64 {
+		if cfg.Soft == 0 {
+			cfg.Soft = uint64(float64(limit) * defaultSoftWatermarkPct)
+		}
+		if cfg.Hard == 0 {
+			cfg.Hard = uint64(float64(limit) * defaultHardWatermarkPct)
+		}
+	}
+	if cfg.Soft == 0 || cfg.Hard == 0 {
+		return nil, errors.New("memory watermarks require soft and hard values or a GOMEMLIMIT")
+	}
+	if cfg.Soft > cfg.Hard {
+		return nil, errors.New("soft memory watermark is above the hard watermark")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultWatermarkInterval
+	}
+
+	ggPressureLevel, err := meter.Int64ObservableGauge("memory_pressure_level", metric.WithDescription("Current memory pressure level, 1 for the active level and 0 otherwise."))
+	if err != nil {
+		return nil, err
+	}
+
+	ctBreaches, err := meter.Int64Counter("memory_watermark_breaches_total", metric.WithDescription("Number of times a heap watermark was crossed upward."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &memoryWatermarkGauges{ggPressureLevel: ggPressureLevel, ctBreaches: ctBreaches, cfg: cfg}, nil
+}
+
+// Collect registers the callback reporting the current pressure level and starts
+// the background check loop, which runs until Stop is called.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to register callbacks.
+//
+// Returns:
+//   - An error if the callback registration fails.
+func (m *memoryWatermarkGauges) Collect(meter metric.Meter) error {
+	cb := func(_ context.Context, observer metric.Observer) error {
+		current := int(m.level.Load())
+		for i, level := range memoryPressureLevels {
+			var value int64
+			if i == current {
+				value = 1
+			}
+			observer.ObserveInt64(m.ggPressureLevel, value, metric.WithAttributes(attribute.String("level", level)))
+		}
+		return nil
+	}
+
+	return m.loop.start(func() (metric.Registration, error) {
+		return meter.RegisterCallback(cb, m.ggPressureLevel)
+	}, m.checkLoop)
+}
+
+// Stop ends the check loop and unregisters the callback.
+func (m *memoryWatermarkGauges) Stop() {
+	m.loop.Stop()
+}
+
+// checkLoop compares the heap size against the watermarks every interval, until
+// stop is closed.
+func (m *memoryWatermarkGauges) checkLoop(stop <-chan struct{}) {
+	samples := []runtimemetrics.Sample{{Name: heapObjectsMetric}}
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		runtimemetrics.Read(samples)
+		m.update(uint64(sampleInt64(samples[0])))
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update sets the pressure level matching the heap size and counts a breach for
+// each watermark crossed upward since the previous check.
+func (m *memoryWatermarkGauges) update(heap uint64) {
+	level := 0
+	switch {
+	case heap >= m.cfg.Hard:
+		level = 2
+	case heap >= m.cfg.Soft:
+		level = 1
+	}
+
+	previous := int(m.level.Swap(int32(level)))
+	for l := previous + 1; l <= level; l++ {
+		m.ctBreaches.Add(context.Background(), 1, metric.WithAttributes(attribute.String("level", memoryPressureLevels[l])))
+	}
+}
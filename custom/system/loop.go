@@ -0,0 +1,72 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// collectorLoop runs the background goroutine of a collector until it is stopped,
+// then unregisters the collector callback. A stopped loop can be started again, when
+// the collector is enabled again through its Toggle.
+type collectorLoop struct {
+	mu           sync.Mutex
+	stop         chan struct{}
+	running      bool
+	registration metric.Registration
+}
+
+// start registers the collector callback and runs fn in a new goroutine. The
+// goroutine isn't started if the registration fails or the loop is running.
+func (l *collectorLoop) start(register func() (metric.Registration, error), fn func(stop <-chan struct{})) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.running {
+		return nil
+	}
+
+	reg, err := register()
+	if err != nil {
+		return err
+	}
+	l.registration = reg
+	l.stop = make(chan struct{})
+	l.running = true
+
+	go fn(l.stop)
+
+	return nil
+}
+
+// Stop ends the goroutine and unregisters the callback, it is a no-op if already stopped.
+func (l *collectorLoop) Stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.running {
+		return
+	}
+	l.running = false
+
+	close(l.stop)
+	_ = l.registration.Unregister()
+}
+
+// wait waits for d, reporting false if the loop is stopped first.
+func wait(stop <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
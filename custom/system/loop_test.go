@@ -0,0 +1,67 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestCollectorStop verifies that stopping the collectors ends their goroutine and
+// unregisters their callback.
+func TestCollectorStop(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+	meter := provider.Meter("test")
+
+	before := runtime.NumGoroutine()
+
+	watermark, err := NewMemoryWatermarkGauges(meter, MemoryWatermarkConfig{Soft: 1 << 40, Hard: 1 << 41, Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock, err := NewClockOffsetGauge(meter, ClockOffsetConfig{Server: "127.0.0.1:1", Interval: time.Millisecond, Timeout: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := &CollectorResult{}
+	result.register(meter, "watermark", func(metric.Meter) (BasicGauges, error) { return watermark, nil })
+	result.register(meter, "clock", func(metric.Meter) (BasicGauges, error) { return clock, nil })
+	if err := result.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if runtime.NumGoroutine() < before+2 {
+		t.Fatalf("got %d goroutines, want the 2 collector loops started", runtime.NumGoroutine()-before)
+	}
+
+	result.Stop()
+	result.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if n := runtime.NumGoroutine() - before; n > 0 {
+		t.Errorf("got %d goroutines left running after Stop", n)
+	}
+
+	rm := &metricdata.ResourceMetrics{}
+	if err := reader.Collect(context.Background(), rm); err != nil {
+		t.Fatal(err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			t.Errorf("got %s reported after Stop", m.Name)
+		}
+	}
+}
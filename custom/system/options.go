@@ -0,0 +1,142 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// GroupMem registers the collector created by NewMemGauges.
+	GroupMem Group = "mem"
+	// GroupSys registers the collector created by NewSysGauge.
+	GroupSys Group = "sys"
+	// GroupMinimal registers the collector created by NewMinimalGauges.
+	GroupMinimal Group = "minimal"
+	// GroupProcess registers the collector created by NewProcessGauges.
+	GroupProcess Group = "process"
+	// GroupCgroup registers the collector created by NewCgroupCPUGauges.
+	GroupCgroup Group = "cgroup"
+	// GroupGoroutine registers the collector created by NewGoroutineStateGauges.
+	GroupGoroutine Group = "goroutine"
+	// GroupGCAdvisor registers the collector created by NewGCAdvisorGauges.
+	GroupGCAdvisor Group = "gc_advisor"
+)
+
+// WithLogger sets the logger of the collector registration messages, such as a
+// *zap.SugaredLogger. Nothing is logged by default, errors are returned instead.
+//
+// Parameters:
+//   - logger: The logger to use, nil to disable logging
+//
+// Returns:
+//   - An Option setting the logger
+func WithLogger(logger Logger) Option {
+	return func(c *collectorConfig) {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		c.logger = logger
+	}
+}
+
+// Debug discards the message.
+func (nopLogger) Debug(...any) {}
+
+// WithProfile selects the collectors profile, overriding the METRICS_SYSTEM_PROFILE
+// environment variable. It is ignored when groups are selected with WithGroups.
+//
+// Parameters:
+//   - profile: The collectors profile
+//
+// Returns:
+//   - An Option setting the profile
+func WithProfile(profile Profile) Option {
+	return func(c *collectorConfig) {
+		c.profile = profile
+	}
+}
+
+// WithGroups selects the collectors to register, replacing the ones of the profile.
+// It can be used multiple times, the groups are appended in the given order.
+//
+// Parameters:
+//   - groups: The collector groups to register
+//
+// Returns:
+//   - An Option selecting the groups
+func WithGroups(groups ...Group) Option {
+	return func(c *collectorConfig) {
+		c.groups = append(c.groups, groups...)
+	}
+}
+
+// WithPrefix prepends prefix to the name of every instrument created by the
+// collectors, for instance "myapp_" to avoid conflicts with another exporter.
+//
+// Parameters:
+//   - prefix: The instrument name prefix
+//
+// Returns:
+//   - An Option setting the prefix
+func WithPrefix(prefix string) Option {
+	return func(c *collectorConfig) {
+		c.prefix = prefix
+	}
+}
+
+// WithInterval sets the refresh interval of the collectors caching their readings,
+// the minimal and goroutine state collectors. Default: DefaultMinimalInterval and
+// DefaultGoroutineStateInterval.
+//
+// Parameters:
+//   - interval: The refresh interval
+//
+// Returns:
+//   - An Option setting the interval
+func WithInterval(interval time.Duration) Option {
+	return func(c *collectorConfig) {
+		c.interval = interval
+	}
+}
+
+// groupsOrDefault returns the selected groups, or the groups of the profile if none was selected.
+func (c *collectorConfig) groupsOrDefault() []Group {
+	switch {
+	case len(c.groups) > 0:
+		return c.groups
+	case c.runtimeStart != nil:
+		return nil
+	case c.profile == MinimalProfile:
+		return []Group{GroupMinimal}
+	default:
+		return []Group{GroupMem, GroupSys}
+	}
+}
+
+// newGroup creates the collector of a group.
+func (c *collectorConfig) newGroup(meter metric.Meter, group Group) (BasicGauges, error) {
+	switch group {
+	case GroupMem:
+		return NewMemGauges(meter)
+	case GroupSys:
+		return NewSysGauge(meter)
+	case GroupMinimal:
+		return NewMinimalGauges(meter, c.interval)
+	case GroupProcess:
+		return NewProcessGauges(meter)
+	case GroupCgroup:
+		return NewCgroupCPUGauges(meter)
+	case GroupGoroutine:
+		return NewGoroutineStateGauges(meter, c.interval)
+	case GroupGCAdvisor:
+		return NewGCAdvisorGauges(meter)
+	default:
+		return nil, fmt.Errorf("unknown collector group %q", group)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"go.opentelemetry.io/otel/metric"
+)
+
+// withPrefix wraps meter to prepend prefix to the instrument names, or returns
+// meter unchanged if the prefix is empty.
+func withPrefix(meter metric.Meter, prefix string) metric.Meter {
+	if prefix == "" {
+		return meter
+	}
+	return &prefixedMeter{Meter: meter, prefix: prefix}
+}
+
+// Int64Counter creates an Int64Counter named with the meter prefix.
+func (m *prefixedMeter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return m.Meter.Int64Counter(m.prefix+name, options...)
+}
+
+// Int64UpDownCounter creates an Int64UpDownCounter named with the meter prefix.
+func (m *prefixedMeter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	return m.Meter.Int64UpDownCounter(m.prefix+name, options...)
+}
+
+// Int64Histogram creates an Int64Histogram named with the meter prefix.
+func (m *prefixedMeter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	return m.Meter.Int64Histogram(m.prefix+name, options...)
+}
+
+// Int64Gauge creates an Int64Gauge named with the meter prefix.
+func (m *prefixedMeter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	return m.Meter.Int64Gauge(m.prefix+name, options...)
+}
+
+// Int64ObservableCounter creates an Int64ObservableCounter named with the meter prefix.
+func (m *prefixedMeter) Int64ObservableCounter(name string, options ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
+	return m.Meter.Int64ObservableCounter(m.prefix+name, options...)
+}
+
+// Int64ObservableUpDownCounter creates an Int64ObservableUpDownCounter named with the meter prefix.
+func (m *prefixedMeter) Int64ObservableUpDownCounter(name string, options ...metric.Int64ObservableUpDownCounterOption) (metric.Int64ObservableUpDownCounter, error) {
+	return m.Meter.Int64ObservableUpDownCounter(m.prefix+name, options...)
+}
+
+// Int64ObservableGauge creates an Int64ObservableGauge named with the meter prefix.
+func (m *prefixedMeter) Int64ObservableGauge(name string, options ...metric.Int64ObservableGaugeOption) (metric.Int64ObservableGauge, error) {
+	return m.Meter.Int64ObservableGauge(m.prefix+name, options...)
+}
+
+// Float64Counter creates a Float64Counter named with the meter prefix.
+func (m *prefixedMeter) Float64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	return m.Meter.Float64Counter(m.prefix+name, options...)
+}
+
+// Float64UpDownCounter creates a Float64UpDownCounter named with the meter prefix.
+func (m *prefixedMeter) Float64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
+	return m.Meter.Float64UpDownCounter(m.prefix+name, options...)
+}
+
+// Float64Histogram creates a Float64Histogram named with the meter prefix.
+func (m *prefixedMeter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return m.Meter.Float64Histogram(m.prefix+name, options...)
+}
+
+// Float64Gauge creates a Float64Gauge named with the meter prefix.
+func (m *prefixedMeter) Float64Gauge(name string, options ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	return m.Meter.Float64Gauge(m.prefix+name, options...)
+}
+
+// Float64ObservableCounter creates a Float64ObservableCounter named with the meter prefix.
+func (m *prefixedMeter) Float64ObservableCounter(name string, options ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
+	return m.Meter.Float64ObservableCounter(m.prefix+name, options...)
+}
+
+// Float64ObservableUpDownCounter creates a Float64ObservableUpDownCounter named with the meter prefix.
+func (m *prefixedMeter) Float64ObservableUpDownCounter(name string, options ...metric.Float64ObservableUpDownCounterOption) (metric.Float64ObservableUpDownCounter, error) {
+	return m.Meter.Float64ObservableUpDownCounter(m.prefix+name, options...)
+}
+
+// Float64ObservableGauge creates a Float64ObservableGauge named with the meter prefix.
+func (m *prefixedMeter) Float64ObservableGauge(name string, options ...metric.Float64ObservableGaugeOption) (metric.Float64ObservableGauge, error) {
+	return m.Meter.Float64ObservableGauge(m.prefix+name, options...)
+}
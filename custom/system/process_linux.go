@@ -0,0 +1,65 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+//go:build linux
+
+package system
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// processCapabilities lists the process metrics available on Linux.
+var processCapabilities = ProcessCapabilities{
+	CPUTime:        true,
+	ResidentMemory: true,
+	OpenFDs:        true,
+	MaxFDs:         true,
+}
+
+// readProcessStats reads the process statistics from getrusage and procfs.
+func readProcessStats() (processStats, error) {
+	var stats processStats
+
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return stats, err
+	}
+	stats.cpuSeconds = timevalSeconds(usage.Utime) + timevalSeconds(usage.Stime)
+
+	// The second field of statm is the resident set size in pages
+	statm, err := os.ReadFile("/proc/self/statm")
+	if err != nil {
+		return stats, err
+	}
+	if fields := bytes.Fields(statm); len(fields) > 1 {
+		pages, err := strconv.ParseInt(string(fields[1]), 10, 64)
+		if err != nil {
+			return stats, err
+		}
+		stats.memoryBytes = pages * int64(os.Getpagesize())
+	}
+
+	fds, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return stats, err
+	}
+	stats.openFDs = int64(len(fds))
+
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return stats, err
+	}
+	stats.maxFDs = int64(limit.Cur)
+
+	return stats, nil
+}
+
+// timevalSeconds converts a syscall.Timeval to seconds.
+func timevalSeconds(tv syscall.Timeval) float64 {
+	return float64(tv.Sec) + float64(tv.Usec)/1e6
+}
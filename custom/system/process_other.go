@@ -0,0 +1,15 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+//go:build !linux && !windows
+
+package system
+
+// processCapabilities reports no process metrics on unsupported platforms.
+var processCapabilities = ProcessCapabilities{}
+
+// readProcessStats returns empty statistics on unsupported platforms.
+func readProcessStats() (processStats, error) {
+	return processStats{}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+//go:build windows
+
+package system
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// processCapabilities lists the process metrics available on Windows.
+// Windows has no file descriptors, handles are reported instead, and the
+// resident memory is the working set size.
+var processCapabilities = ProcessCapabilities{
+	CPUTime:        true,
+	ResidentMemory: true,
+	OpenHandles:    true,
+}
+
+var (
+	modkernel32               = syscall.NewLazyDLL("kernel32.dll")
+	modpsapi                  = syscall.NewLazyDLL("psapi.dll")
+	procGetProcessHandleCount = modkernel32.NewProc("GetProcessHandleCount")
+	procGetProcessMemoryInfo  = modpsapi.NewProc("GetProcessMemoryInfo")
+)
+
+// processMemoryCounters mirrors the PROCESS_MEMORY_COUNTERS structure of psapi.
+type processMemoryCounters struct {
+	cb                         uint32
+	pageFaultCount             uint32
+	peakWorkingSetSize         uintptr
+	workingSetSize             uintptr
+	quotaPeakPagedPoolUsage    uintptr
+	quotaPagedPoolUsage        uintptr
+	quotaPeakNonPagedPoolUsage uintptr
+	quotaNonPagedPoolUsage     uintptr
+	pagefileUsage              uintptr
+	peakPagefileUsage          uintptr
+}
+
+// readProcessStats reads the process statistics from the Windows APIs.
+func readProcessStats() (processStats, error) {
+	var stats processStats
+
+	handle, err := syscall.GetCurrentProcess()
+	if err != nil {
+		return stats, err
+	}
+
+	var creation, exit, kernel, user syscall.Filetime
+	if err := syscall.GetProcessTimes(handle, &creation, &exit, &kernel, &user); err != nil {
+		return stats, err
+	}
+	stats.cpuSeconds = filetimeSeconds(kernel) + filetimeSeconds(user)
+
+	var mem processMemoryCounters
+	mem.cb = uint32(unsafe.Sizeof(mem))
+	if r, _, err := procGetProcessMemoryInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&mem)), uintptr(mem.cb)); r == 0 {
+		return stats, err
+	}
+	stats.memoryBytes = int64(mem.workingSetSize)
+
+	var count uint32
+	if r, _, err := procGetProcessHandleCount.Call(uintptr(handle), uintptr(unsafe.Pointer(&count))); r == 0 {
+		return stats, err
+	}
+	stats.openHandles = int64(count)
+
+	return stats, nil
+}
+
+// filetimeSeconds converts a duration expressed as a Filetime, in 100 nanosecond
+// intervals, to seconds.
+func filetimeSeconds(ft syscall.Filetime) float64 {
+	return float64(uint64(ft.HighDateTime)<<32|uint64(ft.LowDateTime)) / 1e7
+}
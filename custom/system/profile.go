@@ -0,0 +1,242 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package system provides a minimal decoder for the CPU profiles written by runtime/pprof.
+package system
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// errMalformedProfile is returned when the profile protobuf cannot be decoded.
+var errMalformedProfile = errors.New("malformed pprof profile")
+
+// Field numbers of the pprof profile.proto messages used by the decoder.
+const (
+	profileSample      = 2
+	profileLocation    = 4
+	profileFunction    = 5
+	profileStringTable = 6
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+
+	functionID   = 1
+	functionName = 2
+)
+
+// profileSampleData holds the decoded fields of a profile sample.
+type profileSampleData struct {
+	locations []uint64
+	values    []int64
+}
+
+// parseCPUProfile decodes a gzip compressed CPU profile and returns the self time,
+// the last sample value, accumulated per leaf function name.
+func parseCPUProfile(data []byte) (map[string]int64, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		samples   []profileSampleData
+		strs      []string
+		locations = map[uint64]uint64{} // location id -> leaf function id
+		functions = map[uint64]int64{}  // function id -> name string index
+	)
+
+	err = walkFields(raw, func(field int, wire int, v uint64, b []byte) error {
+		switch field {
+		case profileSample:
+			s, err := decodeSample(b)
+			if err != nil {
+				return err
+			}
+			samples = append(samples, s)
+		case profileLocation:
+			id, fn, err := decodeLocation(b)
+			if err != nil {
+				return err
+			}
+			locations[id] = fn
+		case profileFunction:
+			id, name, err := decodeFunction(b)
+			if err != nil {
+				return err
+			}
+			functions[id] = name
+		case profileStringTable:
+			strs = append(strs, string(b))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfTime := map[string]int64{}
+	for _, s := range samples {
+		if len(s.locations) == 0 || len(s.values) == 0 {
+			continue
+		}
+
+		nameIdx, ok := functions[locations[s.locations[0]]]
+		if !ok || nameIdx < 0 || int(nameIdx) >= len(strs) {
+			continue
+		}
+		selfTime[strs[nameIdx]] += s.values[len(s.values)-1]
+	}
+
+	return selfTime, nil
+}
+
+// decodeSample decodes a Sample message.
+func decodeSample(b []byte) (profileSampleData, error) {
+	var s profileSampleData
+	err := walkFields(b, func(field int, wire int, v uint64, payload []byte) error {
+		switch field {
+		case sampleLocationID:
+			ids, err := decodeVarints(wire, v, payload)
+			if err != nil {
+				return err
+			}
+			s.locations = append(s.locations, ids...)
+		case sampleValue:
+			values, err := decodeVarints(wire, v, payload)
+			if err != nil {
+				return err
+			}
+			for _, value := range values {
+				s.values = append(s.values, int64(value))
+			}
+		}
+		return nil
+	})
+	return s, err
+}
+
+// decodeLocation decodes a Location message, returning its id and the function id
+// of its first line, which is the innermost function when calls were inlined.
+func decodeLocation(b []byte) (uint64, uint64, error) {
+	var id, fn uint64
+	var hasLine bool
+	err := walkFields(b, func(field int, _ int, v uint64, payload []byte) error {
+		switch field {
+		case locationID:
+			id = v
+		case locationLine:
+			if hasLine {
+				return nil
+			}
+			hasLine = true
+			return walkFields(payload, func(field int, _ int, v uint64, _ []byte) error {
+				if field == lineFunctionID {
+					fn = v
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	return id, fn, err
+}
+
+// decodeFunction decodes a Function message, returning its id and name string index.
+func decodeFunction(b []byte) (uint64, int64, error) {
+	var id uint64
+	var name int64
+	err := walkFields(b, func(field int, _ int, v uint64, _ []byte) error {
+		switch field {
+		case functionID:
+			id = v
+		case functionName:
+			name = int64(v)
+		}
+		return nil
+	})
+	return id, name, err
+}
+
+// decodeVarints decodes a repeated varint field, either packed or not.
+func decodeVarints(wire int, v uint64, payload []byte) ([]uint64, error) {
+	if wire == 0 {
+		return []uint64{v}, nil
+	}
+
+	var out []uint64
+	for len(payload) > 0 {
+		x, n := binary.Uvarint(payload)
+		if n <= 0 {
+			return nil, errMalformedProfile
+		}
+		out = append(out, x)
+		payload = payload[n:]
+	}
+	return out, nil
+}
+
+// walkFields iterates over the fields of a protobuf message, calling fn with the
+// field number, the wire type, the varint value and the length-delimited payload.
+func walkFields(b []byte, fn func(field int, wire int, v uint64, payload []byte) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errMalformedProfile
+		}
+		b = b[n:]
+
+		field, wire := int(key>>3), int(key&7)
+		var v uint64
+		var payload []byte
+
+		switch wire {
+		case 0:
+			v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return errMalformedProfile
+			}
+			b = b[n:]
+		case 1:
+			if len(b) < 8 {
+				return errMalformedProfile
+			}
+			v = binary.LittleEndian.Uint64(b)
+			b = b[8:]
+		case 2:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < l {
+				return errMalformedProfile
+			}
+			payload = b[n : n+int(l)]
+			b = b[n+int(l):]
+		case 5:
+			if len(b) < 4 {
+				return errMalformedProfile
+			}
+			v = uint64(binary.LittleEndian.Uint32(b))
+			b = b[4:]
+		default:
+			return errMalformedProfile
+		}
+
+		if err := fn(field, wire, v, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"runtime/pprof"
+	"strings"
+	"testing"
+	"time"
+)
+
+// burnSink keeps the result of burnCPU alive.
+var burnSink uint64
+
+// burnCPU spins for d in a leaf function.
+//
+//go:noinline
+func burnCPU(d time.Duration) {
+	x := burnSink
+	for deadline := time.Now().Add(d); time.Now().Before(deadline); {
+		for i := 0; i < 1_000_000; i++ {
+			x = x*6364136223846793005 + 1442695040888963407
+		}
+	}
+	burnSink = x
+}
+
+// TestParseCPUProfile verifies the decoding of a profile written by runtime/pprof.
+func TestParseCPUProfile(t *testing.T) {
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		t.Skipf("CPU profile unavailable: %v", err)
+	}
+	burnCPU(500 * time.Millisecond)
+	pprof.StopCPUProfile()
+
+	selfTime, err := parseCPUProfile(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var total, burn int64
+	for name, v := range selfTime {
+		if v < 0 {
+			t.Errorf("got negative self time %d for %s", v, name)
+		}
+		total += v
+		if strings.HasSuffix(name, "system.burnCPU") {
+			burn += v
+		}
+	}
+	if total == 0 {
+		t.Fatalf("got no self time in %d bytes of profile", buf.Len())
+	}
+	if burn*2 < total {
+		t.Errorf("got burnCPU self time %d of %d, want the most of it: %v", burn, total, selfTime)
+	}
+}
+
+// TestParseCPUProfileMalformed verifies that the truncated profiles are rejected.
+func TestParseCPUProfileMalformed(t *testing.T) {
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		t.Skipf("CPU profile unavailable: %v", err)
+	}
+	burnCPU(50 * time.Millisecond)
+	pprof.StopCPUProfile()
+
+	raw, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plain bytes.Buffer
+	if _, err := plain.ReadFrom(raw); err != nil {
+		t.Fatal(err)
+	}
+
+	// Cutting the profile inside its first message leaves a length past the end
+	var truncated bytes.Buffer
+	zw := gzip.NewWriter(&truncated)
+	_, _ = zw.Write(plain.Bytes()[:4])
+	_ = zw.Close()
+
+	if _, err := parseCPUProfile(truncated.Bytes()); !errors.Is(err, errMalformedProfile) {
+		t.Errorf("got error %v, want errMalformedProfile", err)
+	}
+	if _, err := parseCPUProfile([]byte("not a profile")); err == nil {
+		t.Error("got nil error for a profile that isn't gzip compressed")
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// RuntimeInstrumentationScope is the instrumentation scope of the OpenTelemetry
+// contrib runtime instrumentation, go.opentelemetry.io/contrib/instrumentation/runtime.
+const RuntimeInstrumentationScope = "go.opentelemetry.io/contrib/instrumentation/runtime"
+
+// runtimeAliases maps the semantic convention names of the contrib runtime
+// instrumentation to the Prometheus-style names of the hand-rolled collectors.
+// Only the metrics with the same meaning under both names are mapped, the contrib
+// metrics without hand-rolled equivalent, such as go.memory.limit, go.config.gogc and
+// go.schedule.duration, keep their names.
+var runtimeAliases = map[string]string{
+	"go.goroutine.count":    "go_goroutines",
+	"go.memory.allocated":   "go_memstats_alloc_bytes_total",
+	"go.memory.allocations": "go_memstats_mallocs_total",
+	"go.memory.gc.goal":     "go_memstats_next_gc_bytes",
+	"go.processor.limit":    "go_gomaxprocs",
+}
+
+// WithRuntimeInstrumentation delegates the Go runtime metrics to the OpenTelemetry
+// contrib runtime instrumentation instead of the hand-rolled collectors. The start
+// function is provided by the application, keeping the contrib dependency out of
+// this package:
+//
+//	import "go.opentelemetry.io/contrib/instrumentation/runtime"
+//
+//	system.BasicMetricsCollector(nil, system.WithRuntimeInstrumentation(func() error {
+//		return runtime.Start()
+//	}))
+//
+// Unless groups are selected with WithGroups, no other collector is registered, since
+// they would report the same runtime metrics. Register RuntimeViews in the
+// MeterProvider to keep the Prometheus-style names of existing dashboards.
+//
+// Parameters:
+//   - start: The function starting the contrib runtime instrumentation
+//
+// Returns:
+//   - An Option enabling the runtime instrumentation mode
+func WithRuntimeInstrumentation(start func() error) Option {
+	return func(c *collectorConfig) {
+		c.runtimeStart = start
+	}
+}
+
+// RuntimeViews returns the views renaming the metrics of the contrib runtime
+// instrumentation to the Prometheus-style names of the hand-rolled collectors,
+// for instance go.goroutine.count to go_goroutines, so dashboards keep working
+// after adopting WithRuntimeInstrumentation.
+//
+// Returns:
+//   - The views to register with options.WithViews
+func RuntimeViews() []sdkmetric.View {
+	views := make([]sdkmetric.View, 0, len(runtimeAliases))
+	for name, alias := range runtimeAliases {
+		views = append(views, sdkmetric.NewView(
+			sdkmetric.Instrument{Name: name, Scope: instrumentation.Scope{Name: RuntimeInstrumentationScope}},
+			sdkmetric.Stream{Name: alias},
+		))
+	}
+
+	return views
+}
+
+// Collect starts the contrib runtime instrumentation, which registers its own
+// callbacks on the global MeterProvider.
+//
+// Parameters:
+//   - meter: Unused, the contrib instrumentation creates its own meter.
+//
+// Returns:
+//   - An error if the instrumentation fails to start.
+func (r *runtimeInstrumentation) Collect(_ metric.Meter) error {
+	return r.start()
+}
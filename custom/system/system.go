@@ -7,42 +7,141 @@
 package system
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"strings"
+
 	"go.opentelemetry.io/otel"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// FullProfile registers the complete memory and system collectors.
+	FullProfile Profile = "full"
+	// MinimalProfile registers only goroutines, heap allocation and GC count
+	// with a long refresh interval, for resource-constrained devices.
+	MinimalProfile Profile = "minimal"
 )
 
+// instrumentationScope is the instrumentation scope of the default meter.
+const instrumentationScope = "github.com/goxkit/metrics/custom/system"
+
+// ProfileEnvKey is the environment variable selecting the collectors profile
+// used by BasicMetricsCollector, "full" (default) or "minimal".
+const ProfileEnvKey = "METRICS_SYSTEM_PROFILE"
+
+// NewProfile converts a profile name to the corresponding Profile, case-insensitive.
+// Returns FullProfile if the name doesn't match any known profile.
+func NewProfile(name string) Profile {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case string(MinimalProfile):
+		return MinimalProfile
+	default:
+		return FullProfile
+	}
+}
+
 // BasicMetricsCollector initializes and configures basic system metrics collection.
 // It sets up memory and system gauges and starts the continuous collection of metrics
 // to monitor runtime performance and resource usage of the application.
 //
+// The set of collectors is selected with WithGroups, or by the profile set with
+// WithProfile or the METRICS_SYSTEM_PROFILE environment variable: the minimal profile
+// registers only the collector created by NewMinimalGauges. A failing collector
+// doesn't prevent the others from being registered. The duration of every
+// collector callback is recorded in the metrics.collector.duration histogram.
+//
 // Parameters:
-//   - logger: A logger instance for logging metrics-related messages.
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//   - opts: Optional settings such as the groups, the instrument name prefix and the interval.
 //
 // Returns:
-//   - An error if metrics collection could not be initialized.
-func BasicMetricsCollector(logger *zap.SugaredLogger) error {
-	logger.Debug("configuring basic metrics...")
+//   - The registration status of each collector.
+//   - The errors of the failed collectors joined with errors.Join, nil if all are registered.
+func BasicMetricsCollector(meter metric.Meter, opts ...Option) (*CollectorResult, error) {
+	cfg := &collectorConfig{
+		logger:   nopLogger{},
+		profile:  NewProfile(os.Getenv(ProfileEnvKey)),
+		interval: DefaultMinimalInterval,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
 
-	// Create a meter with an appropriate instrumentation scope name
-	meter := otel.Meter("github.com/goxkit/metrics/custom/system")
+	if meter == nil {
+		meter = otel.Meter(instrumentationScope)
+	}
 
-	// Initialize memory statistics collection
-	mem, err := NewMemGauges(meter)
+	// The self-metric isn't prefixed so it is the same across applications
+	duration, err := newCollectorDuration(meter)
 	if err != nil {
-		return err
+		otel.Handle(err)
 	}
+	meter = withPrefix(meter, cfg.prefix)
 
-	// Initialize system statistics collection (threads, goroutines, etc.)
-	sys, err := NewSysGauge(meter)
-	if err != nil {
-		return err
+	cfg.logger.Debug("configuring basic metrics...")
+
+	result := &CollectorResult{}
+	if cfg.runtimeStart != nil {
+		result.register(meter, "runtime", func(metric.Meter) (BasicGauges, error) {
+			return &runtimeInstrumentation{start: cfg.runtimeStart}, nil
+		})
+	}
+	for _, group := range cfg.groupsOrDefault() {
+		result.register(withCollectorDuration(meter, string(group), duration), string(group), func(meter metric.Meter) (BasicGauges, error) {
+			return cfg.newGroup(meter, group)
+		})
 	}
 
-	logger.Debug("basic metrics configured")
+	cfg.logger.Debug("basic metrics configured")
+	return result, result.Err()
+}
+
+// Err returns the errors of the failed collectors joined with errors.Join,
+// or nil if every collector is registered.
+//
+// Returns:
+//   - The aggregated collector errors
+func (r *CollectorResult) Err() error {
+	errList := make([]error, 0, len(r.Collectors))
+	for _, status := range r.Collectors {
+		if status.Err != nil {
+			errList = append(errList, fmt.Errorf("%s collector: %w", status.Name, status.Err))
+		}
+	}
+
+	return errors.Join(errList...)
+}
 
-	// Start collecting metrics by registering the callbacks
-	mem.Collect(meter)
-	sys.Collect(meter)
+// Stop stops the registered collectors running a background goroutine, see Stopper.
+func (r *CollectorResult) Stop() {
+	for _, t := range r.toggles {
+		t.stop()
+	}
+}
+
+// Toggles returns the toggles of the registered collectors, keyed by collector name,
+// to disable and enable them at runtime, for instance through the admin registry.
+//
+// Returns:
+//   - The toggles of the registered collectors
+func (r *CollectorResult) Toggles() map[string]*Toggle {
+	return maps.Clone(r.toggles)
+}
+
+// register creates the named collector, registers its callbacks and records the outcome.
+func (r *CollectorResult) register(meter metric.Meter, name string, create func(metric.Meter) (BasicGauges, error)) {
+	t := &Toggle{meter: meter, create: create}
+	err := t.Enable()
+	if err == nil {
+		if r.toggles == nil {
+			r.toggles = map[string]*Toggle{}
+		}
+		r.toggles[name] = t
+	}
 
-	return nil
+	r.Collectors = append(r.Collectors, CollectorStatus{Name: name, Registered: err == nil, Err: err})
 }
@@ -0,0 +1,52 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// collectorDurationBuckets are the histogram boundaries of the collector callback
+// durations, from 10µs to 1s.
+var collectorDurationBuckets = []float64{0.00001, 0.00005, 0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1}
+
+// newCollectorDuration creates the metrics.collector.duration histogram.
+func newCollectorDuration(meter metric.Meter) (metric.Float64Histogram, error) {
+	return meter.Float64Histogram("metrics.collector.duration",
+		metric.WithDescription("Duration of the collector callbacks, spot collectors costing meaningful CPU."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(collectorDurationBuckets...),
+	)
+}
+
+// withCollectorDuration wraps meter to record the duration of the callbacks it
+// registers with the collector attribute set to name, or returns meter unchanged
+// if the histogram is nil.
+func withCollectorDuration(meter metric.Meter, name string, duration metric.Float64Histogram) metric.Meter {
+	if duration == nil {
+		return meter
+	}
+	return &timedMeter{
+		Meter:    meter,
+		duration: duration,
+		attrs:    metric.WithAttributeSet(attribute.NewSet(attribute.String("collector", name))),
+	}
+}
+
+// RegisterCallback registers f wrapped to record its duration.
+func (m *timedMeter) RegisterCallback(f metric.Callback, instruments ...metric.Observable) (metric.Registration, error) {
+	timed := func(ctx context.Context, observer metric.Observer) error {
+		start := time.Now()
+		err := f(ctx, observer)
+		m.duration.Record(ctx, time.Since(start).Seconds(), m.attrs)
+		return err
+	}
+
+	return m.Meter.RegisterCallback(timed, instruments...)
+}
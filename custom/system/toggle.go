@@ -0,0 +1,125 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// Toggle disables and enables again a collector registered by
+	// BasicMetricsCollector at runtime. Disabling stops the collector goroutine, if
+	// any, and unregisters its callbacks; enabling creates the collector again. It
+	// implements the admin.Collector interface. A Toggle is safe for concurrent use.
+	Toggle struct {
+		meter  metric.Meter
+		create func(metric.Meter) (BasicGauges, error)
+
+		mu            sync.Mutex
+		enabled       bool
+		registrations []metric.Registration
+		stopper       Stopper
+	}
+
+	// registeringMeter records the callback registrations of a collector.
+	registeringMeter struct {
+		metric.Meter
+		registrations []metric.Registration
+	}
+)
+
+// Enable creates the collector and registers its callbacks, it is a no-op if already
+// enabled.
+//
+// Returns:
+//   - The creation or registration error of the collector
+func (t *Toggle) Enable() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.enabled {
+		return nil
+	}
+
+	meter := &registeringMeter{Meter: t.meter}
+	gauges, err := t.create(meter)
+	if err == nil {
+		err = gauges.Collect(meter)
+	}
+	if err != nil {
+		unregister(meter.registrations)
+		return err
+	}
+
+	t.enabled = true
+	t.registrations = meter.registrations
+	t.stopper, _ = gauges.(Stopper)
+
+	return nil
+}
+
+// Disable stops the collector goroutine, if any, and unregisters its callbacks, it is
+// a no-op if already disabled.
+//
+// Returns:
+//   - The errors of the callbacks failing to unregister
+func (t *Toggle) Disable() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.enabled {
+		return nil
+	}
+
+	if t.stopper != nil {
+		t.stopper.Stop()
+	}
+	err := unregister(t.registrations)
+
+	t.enabled = false
+	t.registrations = nil
+	t.stopper = nil
+
+	return err
+}
+
+// Enabled reports whether the collector is enabled.
+func (t *Toggle) Enabled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.enabled
+}
+
+// stop stops the collector goroutine, if any, leaving its callbacks registered.
+func (t *Toggle) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopper != nil {
+		t.stopper.Stop()
+	}
+}
+
+// RegisterCallback registers f and records the registration.
+func (m *registeringMeter) RegisterCallback(f metric.Callback, instruments ...metric.Observable) (metric.Registration, error) {
+	reg, err := m.Meter.RegisterCallback(f, instruments...)
+	if err == nil {
+		m.registrations = append(m.registrations, reg)
+	}
+	return reg, err
+}
+
+// unregister unregisters the registrations and joins their errors.
+func unregister(registrations []metric.Registration) error {
+	errs := make([]error, 0, len(registrations))
+	for _, reg := range registrations {
+		errs = append(errs, reg.Unregister())
+	}
+	return errors.Join(errs...)
+}
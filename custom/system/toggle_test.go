@@ -0,0 +1,67 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package system
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestToggle verifies that disabling a collector removes its series and that enabling
+// it again restores them.
+func TestToggle(t *testing.T) {
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	result, err := BasicMetricsCollector(provider.Meter("test"), WithGroups(GroupGoroutine))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer result.Stop()
+
+	toggle, ok := result.Toggles()[string(GroupGoroutine)]
+	if !ok || !toggle.Enabled() {
+		t.Fatalf("got toggles %v, want the goroutine collector enabled", result.Toggles())
+	}
+
+	series := func() int {
+		var rm metricdata.ResourceMetrics
+		if err := reader.Collect(ctx, &rm); err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name != "metrics.collector.duration" {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	if series() == 0 {
+		t.Fatal("no metric collected while enabled")
+	}
+
+	if err := toggle.Disable(); err != nil {
+		t.Fatal(err)
+	}
+	if n := series(); n != 0 || toggle.Enabled() {
+		t.Fatalf("got %d metrics and enabled %v after Disable, want none", n, toggle.Enabled())
+	}
+
+	if err := toggle.Enable(); err != nil {
+		t.Fatal(err)
+	}
+	if series() == 0 {
+		t.Fatal("no metric collected after enabling again")
+	}
+}
@@ -8,6 +8,12 @@
 package system
 
 import (
+	"context"
+	runtimemetrics "runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -18,7 +24,16 @@ type (
 	BasicGauges interface {
 		// Collect registers callbacks for the metrics with the provided meter.
 		// This sets up the continuous collection of metrics data from the system.
-		Collect(meter metric.Meter)
+		// It returns an error if the callbacks cannot be registered.
+		Collect(meter metric.Meter) error
+	}
+
+	// Stopper is implemented by the collectors running a background goroutine, such
+	// as the CPU profile, clock offset and memory watermark collectors.
+	Stopper interface {
+		// Stop ends the background goroutine and unregisters the callbacks of the
+		// collector. It is a no-op if already stopped.
+		Stop()
 	}
 
 	// memGauges implements BasicGauges to collect memory-related metrics.
@@ -62,4 +77,292 @@ type (
 		ggCgo       metric.Int64ObservableGauge // Number of CGO calls
 		ggGRoutines metric.Int64ObservableGauge // Number of goroutines currently active
 	}
+
+	// goroutineStateGauges implements BasicGauges to collect goroutine counts by state.
+	// It parses the goroutine profile in the background, revealing what the
+	// goroutines of the process are actually waiting on.
+	goroutineStateGauges struct {
+		ggGoroutinesByState metric.Int64ObservableGauge // Number of goroutines per wait state
+
+		interval time.Duration
+		mu       sync.RWMutex
+		counts   map[string]int64
+
+		loop collectorLoop
+	}
+
+	// cpuProfileGauges implements BasicGauges to export the CPU share of the hottest
+	// functions. It runs short CPU profiles in the background and keeps the top-N
+	// functions of the last profile to be reported on collection.
+	cpuProfileGauges struct {
+		ggCPUShare metric.Float64ObservableGauge // Share of sampled CPU time per function
+
+		cfg CPUProfileConfig
+		mu  sync.RWMutex
+		top []functionShare
+
+		loop collectorLoop
+	}
+
+	// CPUProfileConfig configures the experimental CPU profile collector.
+	// Zero values are replaced by the documented defaults.
+	CPUProfileConfig struct {
+		// Interval is the time between the start of two profiles. Default: 1m.
+		Interval time.Duration
+		// Duration is how long each CPU profile runs. Default: 5s.
+		Duration time.Duration
+		// TopN is the number of functions exported per profile. Default: 10.
+		TopN int
+	}
+
+	// minimalGauges implements BasicGauges with the smallest useful set of runtime
+	// metrics: goroutines, heap allocation and GC count. Values are read from
+	// runtime/metrics, which doesn't stop the world, and are refreshed at most once
+	// per interval.
+	minimalGauges struct {
+		ggGRoutines        metric.Int64ObservableGauge // Number of goroutines currently active
+		ggHeapAllocBytes   metric.Int64ObservableGauge // Bytes allocated and still in use
+		ggGcCompletedCycle metric.Int64ObservableGauge // Number of completed GC cycles
+
+		interval time.Duration
+		mu       sync.Mutex
+		last     time.Time
+		samples  []runtimemetrics.Sample
+	}
+
+	// podRestartGauge implements BasicGauges to report the Kubernetes pod restart
+	// count read from a mounted status file.
+	podRestartGauge struct {
+		ggRestarts metric.Int64ObservableGauge // Number of pod container restarts
+		path       string
+	}
+
+	// clockOffsetGauge implements BasicGauges to report the offset of the local
+	// clock measured against an NTP server in the background.
+	clockOffsetGauge struct {
+		ggOffset metric.Float64ObservableGauge // Local clock offset in seconds
+
+		cfg    ClockOffsetConfig
+		mu     sync.RWMutex
+		offset *float64
+
+		loop collectorLoop
+	}
+
+	// ClockOffsetConfig configures the clock offset collector.
+	// Zero values are replaced by the documented defaults.
+	ClockOffsetConfig struct {
+		// Server is the NTP server address. Default: pool.ntp.org:123.
+		Server string
+		// Interval is the time between two offset measurements. Default: 5m.
+		Interval time.Duration
+		// Timeout is the maximum duration of a measurement. Default: 5s.
+		Timeout time.Duration
+	}
+
+	// cgroupCPUGauges implements BasicGauges to report the CPU throttling of the
+	// cgroup the process runs in, alongside GOMAXPROCS.
+	cgroupCPUGauges struct {
+		ctThrottledPeriods metric.Int64ObservableCounter   // Number of throttled CFS periods
+		ctThrottledTime    metric.Float64ObservableCounter // Total throttled time in seconds
+		ggThrottlingRatio  metric.Float64ObservableGauge   // Throttled periods over elapsed periods since last collection
+		ggGoMaxProcs       metric.Int64ObservableGauge     // Current GOMAXPROCS value
+
+		statPath string
+		mu       sync.Mutex
+		previous *cgroupCPUStat
+	}
+
+	// cgroupCPUStat holds the CFS bandwidth statistics of a cgroup.
+	cgroupCPUStat struct {
+		periods          int64
+		throttledPeriods int64
+		throttledTime    time.Duration
+	}
+
+	// schemaVersionGauges implements BasicGauges to report the current schema
+	// migration version of a database.
+	schemaVersionGauges struct {
+		ggVersion metric.Int64ObservableGauge // Current schema migration version
+		ggDirty   metric.Int64ObservableGauge // Whether the last migration failed midway
+
+		cfg SchemaVersionConfig
+	}
+
+	// SchemaVersionConfig configures the schema version collector.
+	SchemaVersionConfig struct {
+		// Database identifies the database, reported as the database attribute.
+		Database string
+		// Version returns the current schema version, for instance GooseVersion or MigrateVersion.
+		Version SchemaVersionFunc
+		// Timeout is the maximum duration of a version read. Default: 2s.
+		Timeout time.Duration
+	}
+
+	// SchemaVersionFunc returns the current schema migration version and whether the
+	// schema is dirty, that is a migration failed midway.
+	SchemaVersionFunc func(ctx context.Context) (version int64, dirty bool, err error)
+
+	// gcAdvisorGauges implements BasicGauges to report experimental GC tuning
+	// hints computed between two collections.
+	gcAdvisorGauges struct {
+		ggCyclesPerSecond metric.Float64ObservableGauge // GC cycles per second since the last collection
+		ggPauseRatio      metric.Float64ObservableGauge // Share of wall time spent in GC pauses
+		ggCPUFraction     metric.Float64ObservableGauge // Share of CPU time spent in the GC
+		ggHeapGoalRatio   metric.Float64ObservableGauge // Heap goal over live heap
+		ggSuggestedGOGC   metric.Int64ObservableGauge   // Suggested GOGC range bounds
+
+		mu       sync.Mutex
+		previous *gcSnapshot
+		samples  []runtimemetrics.Sample
+	}
+
+	// gcSnapshot holds the cumulative GC statistics read at a collection.
+	gcSnapshot struct {
+		at       time.Time
+		cycles   int64
+		pause    time.Duration
+		gcCPU    float64
+		totalCPU float64
+	}
+
+	// memoryWatermarkGauges implements BasicGauges to report the memory pressure
+	// level derived from soft and hard heap watermarks.
+	memoryWatermarkGauges struct {
+		ggPressureLevel metric.Int64ObservableGauge // 1 for the current pressure level, 0 otherwise
+		ctBreaches      metric.Int64Counter         // Number of times a watermark was crossed upward
+
+		cfg   MemoryWatermarkConfig
+		level atomic.Int32
+
+		loop collectorLoop
+	}
+
+	// MemoryWatermarkConfig configures the memory watermark collector. Watermarks
+	// are compared against the bytes occupied by live and unswept heap objects.
+	MemoryWatermarkConfig struct {
+		// Soft is the heap size in bytes above which the pressure level is soft.
+		// Default: 80% of the GOMEMLIMIT when set.
+		Soft uint64
+		// Hard is the heap size in bytes above which the pressure level is hard.
+		// Default: 95% of the GOMEMLIMIT when set.
+		Hard uint64
+		// Interval is the time between two heap size checks. Default: 1s.
+		Interval time.Duration
+	}
+
+	// CollectorResult reports the registration status of each collector attempted
+	// by BasicMetricsCollector.
+	CollectorResult struct {
+		// Collectors holds one status per collector, in registration order.
+		Collectors []CollectorStatus
+
+		// toggles control the registered collectors, keyed by name.
+		toggles map[string]*Toggle
+	}
+
+	// CollectorStatus is the registration status of a single collector.
+	CollectorStatus struct {
+		// Name identifies the collector, such as "mem" or "sys".
+		Name string
+		// Registered reports whether the collector callbacks are registered.
+		Registered bool
+		// Err is the creation or registration error, nil if registered.
+		Err error
+	}
+
+	// Profile selects the set of system collectors registered by BasicMetricsCollector.
+	Profile string
+
+	// Group identifies a collector that BasicMetricsCollector can register.
+	Group string
+
+	// Logger is the minimal logging interface used by BasicMetricsCollector,
+	// satisfied by *zap.SugaredLogger and most structured loggers.
+	Logger interface {
+		Debug(args ...any)
+	}
+
+	// nopLogger is the Logger discarding every message, used when no logger is set.
+	nopLogger struct{}
+
+	// Option configures BasicMetricsCollector.
+	Option func(*collectorConfig)
+
+	// collectorConfig holds the settings of BasicMetricsCollector.
+	collectorConfig struct {
+		logger   Logger
+		profile  Profile
+		groups   []Group
+		prefix   string
+		interval time.Duration
+
+		// runtimeStart starts the OpenTelemetry contrib runtime instrumentation.
+		runtimeStart func() error
+	}
+
+	// timedMeter wraps a meter to record the duration of the callbacks registered
+	// by a collector in the metrics.collector.duration histogram.
+	timedMeter struct {
+		metric.Meter
+		duration metric.Float64Histogram
+		attrs    metric.MeasurementOption
+	}
+
+	// runtimeInstrumentation implements BasicGauges by delegating the Go runtime
+	// metrics to the OpenTelemetry contrib runtime instrumentation.
+	runtimeInstrumentation struct {
+		start func() error
+	}
+
+	// prefixedMeter wraps a meter to prepend a prefix to the name of every
+	// instrument it creates.
+	prefixedMeter struct {
+		metric.Meter
+		prefix string
+	}
+
+	// processGauges implements BasicGauges to collect process level metrics such as
+	// CPU time, memory and open descriptors. Only the instruments supported by the
+	// current platform are created, see SupportedProcessMetrics.
+	processGauges struct {
+		ctCPUSeconds  metric.Float64ObservableCounter // Total user and system CPU time
+		ggMemoryBytes metric.Int64ObservableGauge     // Resident memory, working set on Windows
+		ggOpenFDs     metric.Int64ObservableGauge     // Number of open file descriptors
+		ggMaxFDs      metric.Int64ObservableGauge     // Maximum number of open file descriptors
+		ggOpenHandles metric.Int64ObservableGauge     // Number of open handles on Windows
+		capabilities  ProcessCapabilities
+		observables   []metric.Observable
+	}
+
+	// ProcessCapabilities reports which process metrics are available on the
+	// platform the application is running on.
+	ProcessCapabilities struct {
+		// CPUTime indicates process_cpu_seconds_total is reported.
+		CPUTime bool
+		// ResidentMemory indicates process_resident_memory_bytes is reported.
+		// On Windows the value is the working set size.
+		ResidentMemory bool
+		// OpenFDs indicates process_open_fds is reported.
+		OpenFDs bool
+		// MaxFDs indicates process_max_fds is reported.
+		MaxFDs bool
+		// OpenHandles indicates process_open_handles is reported, Windows only.
+		OpenHandles bool
+	}
+
+	// processStats holds a snapshot of the process metrics read from the platform.
+	processStats struct {
+		cpuSeconds  float64
+		memoryBytes int64
+		openFDs     int64
+		maxFDs      int64
+		openHandles int64
+	}
+
+	// functionShare holds the CPU share of a single function in a profile.
+	functionShare struct {
+		name  string
+		share float64
+	}
 )
@@ -0,0 +1,170 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package webhook provides instrumentation for outbound webhook delivery. Delivery
+// loops report their attempts and outcomes to an Observer, implemented by Metrics,
+// so they don't depend on the metrics pipeline.
+package webhook
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// Observer is notified by a delivery loop of each attempt and of the final
+	// outcome of each delivery.
+	Observer interface {
+		// Attempted is called after every delivery attempt, retries included.
+		Attempted(ctx context.Context, attempt Attempt)
+		// Delivered is called once a delivery succeeded.
+		Delivered(ctx context.Context, delivery Delivery)
+		// Failed is called once a delivery is abandoned, after the last retry or
+		// on a non-retryable error.
+		Failed(ctx context.Context, delivery Delivery, reason string)
+	}
+
+	// Attempt describes a single delivery attempt.
+	Attempt struct {
+		// Endpoint identifies the destination, such as the subscriber id or host.
+		// Avoid full URLs, which are unbounded.
+		Endpoint string
+		// Number is the attempt number, starting at 1. Attempts above 1 are retries.
+		Number int
+		// StatusCode is the HTTP status code of the response, zero without response.
+		StatusCode int
+		// Duration is the latency of the attempt.
+		Duration time.Duration
+		// PayloadSize is the size of the request body in bytes.
+		PayloadSize int64
+	}
+
+	// Delivery describes the outcome of a delivery.
+	Delivery struct {
+		// Endpoint identifies the destination, as in Attempt.
+		Endpoint string
+		// EnqueuedAt is the time the event was queued for delivery, the start of
+		// the end-to-end delivery latency.
+		EnqueuedAt time.Time
+		// Attempts is the number of attempts made.
+		Attempts int
+	}
+
+	// Metrics implements Observer, recording the webhook delivery metrics.
+	// It is safe for concurrent use.
+	Metrics struct {
+		attempts        metric.Int64Counter
+		retries         metric.Int64Counter
+		failures        metric.Int64Counter
+		attemptDuration metric.Float64Histogram
+		latency         metric.Float64Histogram
+		payloadSize     metric.Int64Histogram
+	}
+)
+
+var _ Observer = (*Metrics)(nil)
+
+// NewMetrics creates the webhook delivery metrics and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//
+// Returns:
+//   - The Metrics observing the deliveries
+//   - An error if the meter instruments cannot be created
+func NewMetrics(meter metric.Meter) (*Metrics, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/webhook")
+	}
+
+	attempts, err := meter.Int64Counter("webhook.delivery.attempts", metric.WithDescription("Number of webhook delivery attempts, by status code."), metric.WithUnit("{attempt}"))
+	if err != nil {
+		return nil, err
+	}
+
+	retries, err := meter.Int64Counter("webhook.delivery.retries", metric.WithDescription("Number of webhook delivery retries."), metric.WithUnit("{attempt}"))
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := meter.Int64Counter("webhook.delivery.failures", metric.WithDescription("Number of abandoned webhook deliveries, by reason."), metric.WithUnit("{delivery}"))
+	if err != nil {
+		return nil, err
+	}
+
+	attemptDuration, err := meter.Float64Histogram("webhook.attempt.duration", metric.WithDescription("Latency of the webhook delivery attempts."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	latency, err := meter.Float64Histogram("webhook.delivery.duration", metric.WithDescription("End-to-end webhook delivery latency, from enqueue to success."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	payloadSize, err := meter.Int64Histogram("webhook.payload.size", metric.WithDescription("Size of the webhook payloads."), metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		attempts:        attempts,
+		retries:         retries,
+		failures:        failures,
+		attemptDuration: attemptDuration,
+		latency:         latency,
+		payloadSize:     payloadSize,
+	}, nil
+}
+
+// Attempted records the attempt, its latency and payload size, and counts it as a
+// retry when it isn't the first one.
+//
+// Parameters:
+//   - ctx: The context of the delivery
+//   - attempt: The attempt description
+func (m *Metrics) Attempted(ctx context.Context, attempt Attempt) {
+	endpoint := attribute.String("webhook.endpoint", attempt.Endpoint)
+	opt := metric.WithAttributes(endpoint)
+
+	status := "none"
+	if attempt.StatusCode > 0 {
+		status = strconv.Itoa(attempt.StatusCode)
+	}
+	m.attempts.Add(ctx, 1, metric.WithAttributes(endpoint, attribute.String("http.response.status_code", status)))
+
+	m.attemptDuration.Record(ctx, attempt.Duration.Seconds(), opt)
+	m.payloadSize.Record(ctx, attempt.PayloadSize, opt)
+	if attempt.Number > 1 {
+		m.retries.Add(ctx, 1, opt)
+	}
+}
+
+// Delivered records the end-to-end latency of a successful delivery.
+//
+// Parameters:
+//   - ctx: The context of the delivery
+//   - delivery: The delivery description
+func (m *Metrics) Delivered(ctx context.Context, delivery Delivery) {
+	if delivery.EnqueuedAt.IsZero() {
+		return
+	}
+	m.latency.Record(ctx, time.Since(delivery.EnqueuedAt).Seconds(), metric.WithAttributes(attribute.String("webhook.endpoint", delivery.Endpoint)))
+}
+
+// Failed counts an abandoned delivery, a terminal failure.
+//
+// Parameters:
+//   - ctx: The context of the delivery
+//   - delivery: The delivery description
+//   - reason: The bounded failure reason, such as "retries_exhausted" or "gone"
+func (m *Metrics) Failed(ctx context.Context, delivery Delivery, reason string) {
+	m.failures.Add(ctx, 1, metric.WithAttributes(attribute.String("webhook.endpoint", delivery.Endpoint), attribute.String("reason", reason)))
+}
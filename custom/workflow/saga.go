@@ -0,0 +1,194 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package workflow
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// NameKey is the attribute key of the workflow name.
+	NameKey = attribute.Key("workflow.name")
+	// StepKey is the attribute key of the workflow step.
+	StepKey = attribute.Key("workflow.step")
+	// FromStepKey is the attribute key of the step a transition leaves.
+	FromStepKey = attribute.Key("workflow.step.from")
+	// OutcomeKey is the attribute key of the terminal outcome of a workflow.
+	OutcomeKey = attribute.Key("workflow.outcome")
+
+	// initialStep is the from step of the first transition of a saga.
+	initialStep = "start"
+)
+
+// Outcome is the terminal outcome of a saga.
+type Outcome string
+
+// Terminal outcomes of a saga.
+const (
+	// OutcomeCompleted is a saga whose steps all succeeded.
+	OutcomeCompleted Outcome = "completed"
+	// OutcomeCompensated is a failed saga whose completed steps were compensated.
+	OutcomeCompensated Outcome = "compensated"
+	// OutcomeFailed is a failed saga left without, or with failed, compensations.
+	OutcomeFailed Outcome = "failed"
+)
+
+type (
+	// SagaMetrics records the state machine of multi-step business workflows, or sagas:
+	//   - workflow.step.transitions: counter of the transitions between two steps
+	//   - workflow.step.duration: histogram of the time spent in each step
+	//   - workflow.compensations: counter of the compensated steps
+	//   - workflow.outcomes: counter of the terminal outcomes
+	//   - workflow.duration: histogram of the saga durations, by outcome
+	//
+	// All are recorded with the workflow.name attribute. SagaMetrics is safe for concurrent use.
+	SagaMetrics struct {
+		transitions   metric.Int64Counter
+		stepDuration  metric.Float64Histogram
+		compensations metric.Int64Counter
+		outcomes      metric.Int64Counter
+		duration      metric.Float64Histogram
+	}
+
+	// Saga is a running saga, moving from step to step until it is finished. A Saga
+	// follows the sequential flow of its workflow and is not safe for concurrent use.
+	Saga struct {
+		metrics *SagaMetrics
+		name    attribute.KeyValue
+
+		started     time.Time
+		step        string
+		stepStarted time.Time
+		finished    bool
+	}
+)
+
+// NewSagaMetrics creates the saga metrics and their instruments.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//
+// Returns:
+//   - The SagaMetrics
+//   - An error if the meter instruments cannot be created
+func NewSagaMetrics(meter metric.Meter) (*SagaMetrics, error) {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/workflow")
+	}
+
+	transitions, err := meter.Int64Counter("workflow.step.transitions", metric.WithDescription("Transitions between two workflow steps."), metric.WithUnit("{transition}"))
+	if err != nil {
+		return nil, err
+	}
+
+	stepDuration, err := meter.Float64Histogram("workflow.step.duration", metric.WithDescription("Time spent in a workflow step."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	compensations, err := meter.Int64Counter("workflow.compensations", metric.WithDescription("Compensated workflow steps."), metric.WithUnit("{step}"))
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes, err := meter.Int64Counter("workflow.outcomes", metric.WithDescription("Finished workflows, by terminal outcome."), metric.WithUnit("{workflow}"))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("workflow.duration", metric.WithDescription("Duration of the finished workflows, by terminal outcome."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SagaMetrics{
+		transitions:   transitions,
+		stepDuration:  stepDuration,
+		compensations: compensations,
+		outcomes:      outcomes,
+		duration:      duration,
+	}, nil
+}
+
+// Start starts a saga of the named workflow.
+//
+// Parameters:
+//   - name: The workflow name, such as "checkout"
+//
+// Returns:
+//   - The running Saga
+func (m *SagaMetrics) Start(name string) *Saga {
+	now := time.Now()
+
+	return &Saga{
+		metrics:     m,
+		name:        NameKey.String(name),
+		started:     now,
+		step:        initialStep,
+		stepStarted: now,
+	}
+}
+
+// Step moves the saga to the given step, recording the transition from the current
+// step and the time spent in it.
+//
+// Parameters:
+//   - ctx: The context of the saga
+//   - step: The step the saga enters
+func (s *Saga) Step(ctx context.Context, step string) {
+	if s.finished {
+		return
+	}
+
+	s.endStep(ctx)
+	s.metrics.transitions.Add(ctx, 1, metric.WithAttributes(s.name, FromStepKey.String(s.step), StepKey.String(step)))
+
+	s.step = step
+	s.stepStarted = time.Now()
+}
+
+// Compensate records the compensation of a completed step, undoing its effects after
+// a later step failed.
+//
+// Parameters:
+//   - ctx: The context of the saga
+//   - step: The compensated step
+func (s *Saga) Compensate(ctx context.Context, step string) {
+	s.metrics.compensations.Add(ctx, 1, metric.WithAttributes(s.name, StepKey.String(step)))
+}
+
+// Finish ends the saga, recording the time spent in the current step, the terminal
+// outcome and the saga duration. Calls after the first one are ignored.
+//
+// Parameters:
+//   - ctx: The context of the saga
+//   - outcome: The terminal outcome
+func (s *Saga) Finish(ctx context.Context, outcome Outcome) {
+	if s.finished {
+		return
+	}
+	s.finished = true
+
+	s.endStep(ctx)
+
+	opt := metric.WithAttributes(s.name, OutcomeKey.String(string(outcome)))
+	s.metrics.outcomes.Add(ctx, 1, opt)
+	s.metrics.duration.Record(ctx, time.Since(s.started).Seconds(), opt)
+}
+
+// endStep records the time spent in the current step, unless the saga has not entered one yet.
+func (s *Saga) endStep(ctx context.Context) {
+	if s.step == initialStep {
+		return
+	}
+
+	s.metrics.stepDuration.Record(ctx, time.Since(s.stepStarted).Seconds(), metric.WithAttributes(s.name, StepKey.String(s.step)))
+}
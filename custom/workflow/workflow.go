@@ -0,0 +1,230 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package workflow routes the internal metrics of workflow engine SDKs, such as
+// Temporal, into the MeterProvider of this module, so workflow workers need a
+// single metrics pipeline.
+//
+// Handler mirrors the Temporal client.MetricsHandler method set without importing
+// the Temporal SDK. The application adapts it with a few lines, returning the SDK
+// interface types:
+//
+//	type temporalHandler struct{ h *workflow.Handler }
+//
+//	func (t temporalHandler) WithTags(tags map[string]string) client.MetricsHandler {
+//		return temporalHandler{t.h.WithTags(tags)}
+//	}
+//	func (t temporalHandler) Counter(name string) client.MetricsCounter { return t.h.Counter(name) }
+//	func (t temporalHandler) Gauge(name string) client.MetricsGauge     { return t.h.Gauge(name) }
+//	func (t temporalHandler) Timer(name string) client.MetricsTimer     { return t.h.Timer(name) }
+//
+//	c, err := client.Dial(client.Options{MetricsHandler: temporalHandler{workflow.NewHandler(nil)}})
+package workflow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+)
+
+type (
+	// Handler creates the counters, gauges and timers requested by a workflow SDK.
+	// Tags added with WithTags are recorded as attributes. A Handler is safe for
+	// concurrent use, the instruments are shared by the handlers derived with WithTags.
+	Handler struct {
+		instruments *instruments
+		tags        map[string]string
+		opt         metric.MeasurementOption
+	}
+
+	// Counter is a monotonic counter, satisfying the Temporal client.MetricsCounter interface.
+	Counter struct {
+		counter metric.Int64Counter
+		opt     metric.MeasurementOption
+	}
+
+	// Gauge is a last value gauge, satisfying the Temporal client.MetricsGauge interface.
+	Gauge struct {
+		gauge metric.Float64Gauge
+		opt   metric.MeasurementOption
+	}
+
+	// Timer is a duration histogram in seconds, satisfying the Temporal client.MetricsTimer interface.
+	Timer struct {
+		histogram metric.Float64Histogram
+		opt       metric.MeasurementOption
+	}
+
+	// instruments caches the instruments created by name.
+	instruments struct {
+		meter      metric.Meter
+		mu         sync.Mutex
+		counters   map[string]metric.Int64Counter
+		gauges     map[string]metric.Float64Gauge
+		histograms map[string]metric.Float64Histogram
+	}
+)
+
+// NewHandler creates a Handler creating its instruments with meter.
+//
+// Parameters:
+//   - meter: The meter used to create the instruments, the global meter of the
+//     package instrumentation scope if nil.
+//
+// Returns:
+//   - A Handler without tags
+func NewHandler(meter metric.Meter) *Handler {
+	if meter == nil {
+		meter = otel.Meter("github.com/goxkit/metrics/custom/workflow")
+	}
+
+	return &Handler{
+		instruments: &instruments{
+			meter:      meter,
+			counters:   map[string]metric.Int64Counter{},
+			gauges:     map[string]metric.Float64Gauge{},
+			histograms: map[string]metric.Float64Histogram{},
+		},
+		opt: metric.WithAttributeSet(*attribute.EmptySet()),
+	}
+}
+
+// WithTags returns a Handler recording tags, merged with the tags of h, as attributes.
+// The tags of the argument take precedence.
+//
+// Parameters:
+//   - tags: The tags to add
+//
+// Returns:
+//   - A Handler sharing the instruments of h
+func (h *Handler) WithTags(tags map[string]string) *Handler {
+	merged := make(map[string]string, len(h.tags)+len(tags))
+	for k, v := range h.tags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+
+	attrs := make([]attribute.KeyValue, 0, len(merged))
+	for k, v := range merged {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+
+	return &Handler{
+		instruments: h.instruments,
+		tags:        merged,
+		opt:         metric.WithAttributeSet(attribute.NewSet(attrs...)),
+	}
+}
+
+// Counter returns the counter named name.
+//
+// Parameters:
+//   - name: The metric name
+//
+// Returns:
+//   - The Counter recording with the tags of h
+func (h *Handler) Counter(name string) *Counter {
+	return &Counter{counter: h.instruments.counter(name), opt: h.opt}
+}
+
+// Gauge returns the gauge named name.
+//
+// Parameters:
+//   - name: The metric name
+//
+// Returns:
+//   - The Gauge recording with the tags of h
+func (h *Handler) Gauge(name string) *Gauge {
+	return &Gauge{gauge: h.instruments.gauge(name), opt: h.opt}
+}
+
+// Timer returns the timer named name.
+//
+// Parameters:
+//   - name: The metric name
+//
+// Returns:
+//   - The Timer recording with the tags of h
+func (h *Handler) Timer(name string) *Timer {
+	return &Timer{histogram: h.instruments.histogram(name), opt: h.opt}
+}
+
+// Inc increments the counter by delta.
+func (c *Counter) Inc(delta int64) {
+	c.counter.Add(context.Background(), delta, c.opt)
+}
+
+// Update sets the gauge to value.
+func (g *Gauge) Update(value float64) {
+	g.gauge.Record(context.Background(), value, g.opt)
+}
+
+// Record records duration in seconds.
+func (t *Timer) Record(duration time.Duration) {
+	t.histogram.Record(context.Background(), duration.Seconds(), t.opt)
+}
+
+// counter returns the cached counter named name, creating it if needed. Creation
+// errors are reported to the OpenTelemetry error handler and a no-op counter is returned.
+func (i *instruments) counter(name string) metric.Int64Counter {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if c, ok := i.counters[name]; ok {
+		return c
+	}
+
+	c, err := i.meter.Int64Counter(name)
+	if err != nil {
+		otel.Handle(err)
+		c = metricnoop.Int64Counter{}
+	}
+	i.counters[name] = c
+	return c
+}
+
+// gauge returns the cached gauge named name, creating it if needed. Creation
+// errors are reported to the OpenTelemetry error handler and a no-op gauge is returned.
+func (i *instruments) gauge(name string) metric.Float64Gauge {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if g, ok := i.gauges[name]; ok {
+		return g
+	}
+
+	g, err := i.meter.Float64Gauge(name)
+	if err != nil {
+		otel.Handle(err)
+		g = metricnoop.Float64Gauge{}
+	}
+	i.gauges[name] = g
+	return g
+}
+
+// histogram returns the cached histogram named name, creating it if needed. Creation
+// errors are reported to the OpenTelemetry error handler and a no-op histogram is returned.
+func (i *instruments) histogram(name string) metric.Float64Histogram {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if h, ok := i.histograms[name]; ok {
+		return h
+	}
+
+	h, err := i.meter.Float64Histogram(name, metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+		h = metricnoop.Float64Histogram{}
+	}
+	i.histograms[name] = h
+	return h
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DeprecationWarningInterval is the minimum interval between two warnings of the same
+// deprecated instrument reported to the OpenTelemetry error handler.
+var DeprecationWarningInterval = time.Hour
+
+// ErrDeprecatedInstrument is reported to the OpenTelemetry error handler when a
+// deprecated instrument is recorded.
+var ErrDeprecatedInstrument = errors.New("metrics: deprecated instrument")
+
+const (
+	// DeprecatedInstrumentKey is the attribute key of the deprecated instrument name.
+	DeprecatedInstrumentKey = attribute.Key("metrics.deprecated.instrument")
+	// DeprecatedReplacementKey is the attribute key of the replacement instrument name.
+	DeprecatedReplacementKey = attribute.Key("metrics.deprecated.replacement")
+	// DeprecatedSinceKey is the attribute key of the version deprecating the instrument.
+	DeprecatedSinceKey = attribute.Key("metrics.deprecated.since")
+)
+
+// Deprecation describes the deprecation of an instrument, so the services still
+// recording it can be found and migrated before its removal.
+type Deprecation struct {
+	// Replacement is the name of the instrument replacing the deprecated one, if any.
+	Replacement string
+	// Since is the version deprecating the instrument, such as "v2.3.0".
+	Since string
+	// RemovedIn is the version removing the instrument, if planned.
+	RemovedIn string
+}
+
+// deprecatedInstrument is a registered deprecation.
+type deprecatedInstrument struct {
+	Deprecation
+	attrs    metric.MeasurementOption
+	lastWarn atomic.Int64
+}
+
+var (
+	// deprecationsMu guards deprecations.
+	deprecationsMu sync.RWMutex
+	// deprecations holds the deprecated instruments by name.
+	deprecations = map[string]*deprecatedInstrument{}
+	// hasDeprecations skips the lookup on the recording path until an instrument is deprecated.
+	hasDeprecations atomic.Bool
+)
+
+// Deprecate marks the instrument as deprecated. Every time a Recorder records it, the
+// metrics.deprecated.recordings counter is incremented, and at most once every
+// DeprecationWarningInterval a warning wrapping ErrDeprecatedInstrument is reported
+// to the OpenTelemetry error handler. The instrument is still recorded, so it can be
+// migrated gracefully across services. Deprecating an instrument again replaces its
+// deprecation.
+//
+// Parameters:
+//   - name: The deprecated instrument name
+//   - deprecation: The replacement and the versions of the deprecation
+func Deprecate(name string, deprecation Deprecation) {
+	d := &deprecatedInstrument{
+		Deprecation: deprecation,
+		attrs: metric.WithAttributeSet(attribute.NewSet(
+			DeprecatedInstrumentKey.String(name),
+			DeprecatedReplacementKey.String(deprecation.Replacement),
+			DeprecatedSinceKey.String(deprecation.Since),
+		)),
+	}
+
+	deprecationsMu.Lock()
+	defer deprecationsMu.Unlock()
+
+	deprecations[name] = d
+	hasDeprecations.Store(true)
+}
+
+// Deprecated returns the deprecation of the instrument, if deprecated.
+//
+// Parameters:
+//   - name: The instrument name
+//
+// Returns:
+//   - The deprecation of the instrument
+//   - Whether the instrument is deprecated
+func Deprecated(name string) (Deprecation, bool) {
+	if d, ok := deprecated(name); ok {
+		return d.Deprecation, true
+	}
+	return Deprecation{}, false
+}
+
+// warnDeprecated records the use of the instrument cached under key if it is deprecated.
+func (r *Recorder) warnDeprecated(key string) {
+	if !hasDeprecations.Load() {
+		return
+	}
+
+	// The cache keys are the instrument kind and name, followed by the unit for durations
+	_, name, _ := strings.Cut(key, ":")
+	name, _, _ = strings.Cut(name, ":")
+	d, ok := deprecated(name)
+	if !ok {
+		return
+	}
+
+	r.deprecationOnce.Do(func() {
+		counter, err := r.meter.Int64Counter("metrics.deprecated.recordings", metric.WithDescription("Number of recordings of deprecated instruments."), metric.WithUnit("{recording}"))
+		if err != nil {
+			otel.Handle(err)
+		}
+		r.deprecationCounter = counter
+	})
+	if r.deprecationCounter != nil {
+		r.deprecationCounter.Add(context.Background(), 1, d.attrs)
+	}
+
+	now := time.Now().UnixNano()
+	last := d.lastWarn.Load()
+	if last != 0 && now-last < int64(DeprecationWarningInterval) || !d.lastWarn.CompareAndSwap(last, now) {
+		return
+	}
+	otel.Handle(d.warning(name))
+}
+
+// warning returns the warning of the deprecated instrument.
+func (d *deprecatedInstrument) warning(name string) error {
+	msg := name
+	if d.Since != "" {
+		msg += " since " + d.Since
+	}
+	if d.RemovedIn != "" {
+		msg += ", removed in " + d.RemovedIn
+	}
+	if d.Replacement != "" {
+		msg += ", use " + d.Replacement
+	}
+	return fmt.Errorf("%w: %s", ErrDeprecatedInstrument, msg)
+}
+
+// deprecated returns the registered deprecation of the instrument.
+func deprecated(name string) (*deprecatedInstrument, bool) {
+	deprecationsMu.RLock()
+	defer deprecationsMu.RUnlock()
+
+	d, ok := deprecations[name]
+	return d, ok
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package derived provides a small recording rules engine computing derived metrics,
+// such as error_rate = errors / requests over 1m, from the metrics of the application.
+// Rules are evaluated at collection time and exported as gauges, for backends lacking
+// query-time math.
+package derived
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/internal/aggregate"
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// instrumentationScope is the scope of the derived metrics.
+const instrumentationScope = "github.com/goxkit/metrics/derived"
+
+type (
+	// Rule defines a derived metric exported as a gauge.
+	Rule struct {
+		// Name is the name of the derived gauge.
+		Name string
+		// Description is the description of the derived gauge.
+		Description string
+		// Unit is the unit of the derived gauge.
+		Unit string
+		// Expr is the expression computing the gauge value.
+		Expr Expr
+	}
+
+	// Engine evaluates the rules on every collection. It reads the application metrics
+	// with its own manual reader and produces the derived gauges as an external
+	// producer of the exporting reader.
+	Engine struct {
+		rules  []Rule
+		reader *sdkmetric.ManualReader
+
+		mu      sync.Mutex
+		history map[string][]sample
+	}
+
+	// sample is a value of a series at a point in time.
+	sample struct {
+		at    time.Time
+		value float64
+	}
+
+	// snapshot holds the series values of a collection and the engine history.
+	snapshot struct {
+		now     time.Time
+		values  map[string]float64
+		history map[string][]sample
+	}
+)
+
+// New creates an Engine evaluating the rules. The engine must be registered in the
+// MeterProvider with the options returned by Options.
+//
+// Parameters:
+//   - rules: The derived metrics definitions
+//
+// Returns:
+//   - A new Engine
+func New(rules ...Rule) *Engine {
+	return &Engine{
+		rules:   rules,
+		reader:  sdkmetric.NewManualReader(),
+		history: map[string][]sample{},
+	}
+}
+
+// Options returns the Install options registering the engine reader and producer.
+//
+// Returns:
+//   - The options to pass to metrics.Install
+func (e *Engine) Options() []options.Option {
+	return []options.Option{
+		options.WithReaders(e.reader),
+		options.WithProducers(e),
+	}
+}
+
+// Produce collects the application metrics, evaluates the rules and returns the
+// derived gauges. Rules without value, such as divisions by zero, are skipped.
+func (e *Engine) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	var rm metricdata.ResourceMetrics
+	if err := e.reader.Collect(ctx, &rm); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s := &snapshot{now: time.Now(), values: map[string]float64{}, history: e.history}
+	for _, rule := range e.rules {
+		for _, ref := range rule.Expr.series() {
+			key := ref.key()
+			if _, ok := s.values[key]; ok {
+				continue
+			}
+			if v, ok := sumSeries(&rm, ref); ok {
+				s.values[key] = v
+				e.record(key, ref.window, s.now, v)
+			}
+		}
+	}
+
+	metrics := make([]metricdata.Metrics, 0, len(e.rules))
+	for _, rule := range e.rules {
+		v, ok := rule.Expr.eval(s)
+		if !ok {
+			continue
+		}
+
+		metrics = append(metrics, metricdata.Metrics{
+			Name:        rule.Name,
+			Description: rule.Description,
+			Unit:        rule.Unit,
+			Data: metricdata.Gauge[float64]{
+				DataPoints: []metricdata.DataPoint[float64]{{Time: s.now, Value: v}},
+			},
+		})
+	}
+
+	return []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: instrumentationScope},
+		Metrics: metrics,
+	}}, nil
+}
+
+// record appends a sample to the series history, dropping the samples no longer
+// needed to cover the window.
+func (e *Engine) record(key string, window time.Duration, now time.Time, v float64) {
+	h := append(e.history[key], sample{at: now, value: v})
+
+	// Keep the most recent sample older than the window as the increase base
+	cut := 0
+	for i := range h {
+		if now.Sub(h[i].at) >= window {
+			cut = i
+		}
+	}
+	e.history[key] = h[cut:]
+}
+
+// key identifies a series in the snapshot and the history.
+func (r seriesRef) key() string {
+	return r.metric + "|" + string(r.filter.Encoded(attribute.DefaultEncoder())) + "|" + r.window.String()
+}
+
+// value returns the current value of the series.
+func (s *snapshot) value(ref seriesRef) (float64, bool) {
+	v, ok := s.values[ref.key()]
+	return v, ok
+}
+
+// increase returns the increase of the series over its window and the elapsed time
+// between the base sample and now. A decrease is handled as a counter reset.
+func (s *snapshot) increase(ref seriesRef) (float64, time.Duration, bool) {
+	h := s.history[ref.key()]
+	if len(h) < 2 {
+		return 0, 0, false
+	}
+
+	base, last := h[0], h[len(h)-1]
+	if last.value < base.value {
+		return last.value, last.at.Sub(base.at), true
+	}
+	return last.value - base.value, last.at.Sub(base.at), true
+}
+
+// sumSeries sums the data points of the metric matching the series attributes, the
+// counts of the histograms.
+func sumSeries(rm *metricdata.ResourceMetrics, ref seriesRef) (float64, bool) {
+	match := func(attrs attribute.Set) bool { return matches(attrs, ref.filter) }
+
+	var totals aggregate.Totals
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != ref.metric {
+				continue
+			}
+			if t, ok := aggregate.Total(m.Data, match); ok {
+				totals.Add(t)
+			}
+		}
+	}
+
+	if totals.Kind == aggregate.KindHistogram {
+		return totals.Count, totals.Points > 0
+	}
+	return totals.Value, totals.Points > 0
+}
+
+// matches reports whether the data point attributes contain all the filter attributes.
+func matches(attrs attribute.Set, filter attribute.Set) bool {
+	iter := filter.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		if v, ok := attrs.Value(kv.Key); !ok || v != kv.Value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package derived
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type (
+	// Expr is an expression evaluated over the collected metrics.
+	// Expressions are built with the functions of this package.
+	Expr interface {
+		// eval computes the expression, returning false when it has no value,
+		// such as a division by zero or a missing metric.
+		eval(s *snapshot) (float64, bool)
+		// series lists the series read by the expression.
+		series() []seriesRef
+	}
+
+	// seriesRef identifies the sum of the data points of a metric matching attributes.
+	seriesRef struct {
+		metric string
+		filter attribute.Set
+		window time.Duration
+	}
+
+	valueExpr    struct{ ref seriesRef }
+	increaseExpr struct{ ref seriesRef }
+	rateExpr     struct{ ref seriesRef }
+	constExpr    float64
+
+	binaryExpr struct {
+		a, b Expr
+		op   func(a, b float64) (float64, bool)
+	}
+)
+
+// Value is the current value of a metric: the sum of its data points matching the
+// attributes. For histograms the count of recorded values is used.
+//
+// Parameters:
+//   - metric: The metric name
+//   - attrs: The attributes the data points must have, all data points if empty
+func Value(metric string, attrs ...attribute.KeyValue) Expr {
+	return valueExpr{seriesRef{metric: metric, filter: attribute.NewSet(attrs...)}}
+}
+
+// Increase is the increase of a cumulative metric over the window, handling resets.
+// Until the window is covered, the increase since the first evaluation is used.
+//
+// Parameters:
+//   - metric: The metric name
+//   - window: The time window of the increase
+//   - attrs: The attributes the data points must have, all data points if empty
+func Increase(metric string, window time.Duration, attrs ...attribute.KeyValue) Expr {
+	return increaseExpr{seriesRef{metric: metric, filter: attribute.NewSet(attrs...), window: window}}
+}
+
+// Rate is the per-second increase of a cumulative metric over the window.
+//
+// Parameters:
+//   - metric: The metric name
+//   - window: The time window of the rate
+//   - attrs: The attributes the data points must have, all data points if empty
+func Rate(metric string, window time.Duration, attrs ...attribute.KeyValue) Expr {
+	return rateExpr{seriesRef{metric: metric, filter: attribute.NewSet(attrs...), window: window}}
+}
+
+// Const is a constant value.
+func Const(v float64) Expr {
+	return constExpr(v)
+}
+
+// Add is a + b.
+func Add(a, b Expr) Expr {
+	return binaryExpr{a, b, func(x, y float64) (float64, bool) { return x + y, true }}
+}
+
+// Sub is a - b.
+func Sub(a, b Expr) Expr {
+	return binaryExpr{a, b, func(x, y float64) (float64, bool) { return x - y, true }}
+}
+
+// Mul is a * b.
+func Mul(a, b Expr) Expr {
+	return binaryExpr{a, b, func(x, y float64) (float64, bool) { return x * y, true }}
+}
+
+// Div is a / b, without value when b is zero.
+func Div(a, b Expr) Expr {
+	return binaryExpr{a, b, func(x, y float64) (float64, bool) {
+		if y == 0 {
+			return 0, false
+		}
+		return x / y, true
+	}}
+}
+
+func (e valueExpr) eval(s *snapshot) (float64, bool) {
+	return s.value(e.ref)
+}
+
+func (e valueExpr) series() []seriesRef { return []seriesRef{e.ref} }
+
+func (e increaseExpr) eval(s *snapshot) (float64, bool) {
+	inc, _, ok := s.increase(e.ref)
+	return inc, ok
+}
+
+func (e increaseExpr) series() []seriesRef { return []seriesRef{e.ref} }
+
+func (e rateExpr) eval(s *snapshot) (float64, bool) {
+	inc, elapsed, ok := s.increase(e.ref)
+	if !ok || elapsed <= 0 {
+		return 0, false
+	}
+	return inc / elapsed.Seconds(), true
+}
+
+func (e rateExpr) series() []seriesRef { return []seriesRef{e.ref} }
+
+func (e constExpr) eval(*snapshot) (float64, bool) { return float64(e), true }
+
+func (e constExpr) series() []seriesRef { return nil }
+
+func (e binaryExpr) eval(s *snapshot) (float64, bool) {
+	a, ok := e.a.eval(s)
+	if !ok {
+		return 0, false
+	}
+	b, ok := e.b.eval(s)
+	if !ok {
+		return 0, false
+	}
+	return e.op(a, b)
+}
+
+func (e binaryExpr) series() []seriesRef {
+	return append(e.a.series(), e.b.series()...)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package detectors provides OpenTelemetry resource detectors that enrich the
+// MeterProvider resource with attributes read from the environment the
+// application is running on.
+package detectors
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// ResourceAttrsEnvKey is the environment variable holding static resource attributes
+// as comma separated key=value pairs.
+//
+// Example:
+//
+// METRICS_RESOURCE_ATTRS=team=payments,region=eu-west-1
+const ResourceAttrsEnvKey = "METRICS_RESOURCE_ATTRS"
+
+type (
+	// envDetector detects static resource attributes from METRICS_RESOURCE_ATTRS.
+	envDetector struct{}
+)
+
+// Env returns a detector adding the key-values of the METRICS_RESOURCE_ATTRS
+// environment variable to the resource, so organization tagging policies can be
+// applied without code changes. Malformed pairs and empty keys are ignored.
+//
+// Returns:
+//   - A resource.Detector reading METRICS_RESOURCE_ATTRS
+func Env() resource.Detector {
+	return envDetector{}
+}
+
+// Detect parses METRICS_RESOURCE_ATTRS into a resource.
+func (envDetector) Detect(context.Context) (*resource.Resource, error) {
+	attrs := ParseKeyValues(os.Getenv(ResourceAttrsEnvKey))
+	if len(attrs) == 0 {
+		return resource.Empty(), nil
+	}
+
+	return resource.NewSchemaless(attrs...), nil
+}
+
+// Local reports whether d is a detector of this package, which only read the local
+// environment, environment variables and files, and never call a metadata endpoint.
+//
+// Parameters:
+//   - d: The detector
+//
+// Returns:
+//   - true if d only reads the local environment
+func Local(d resource.Detector) bool {
+	switch d.(type) {
+	case envDetector, instanceIDDetector, kubernetesDetector, serverlessDetector:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseKeyValues parses comma separated key=value pairs into string attributes.
+// Keys and values are trimmed, pairs without "=" or with an empty key are ignored.
+//
+// Parameters:
+//   - s: The key-values to parse, for example "team=payments,region=eu-west-1"
+//
+// Returns:
+//   - The parsed attributes, in the given order
+func ParseKeyValues(s string) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+
+	for kv := range strings.SplitSeq(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		if key != "" {
+			attrs = append(attrs, attribute.String(key, value))
+		}
+	}
+
+	return attrs
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package detectors
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+const (
+	// InstanceIDRandom generates a random UUID, stable for the process lifetime.
+	InstanceIDRandom InstanceIDSource = "random"
+	// InstanceIDPodUID uses the Kubernetes pod uid, or a random UUID outside of Kubernetes.
+	InstanceIDPodUID InstanceIDSource = "pod_uid"
+	// InstanceIDHostname uses the host name, or a random UUID if it is unavailable.
+	InstanceIDHostname InstanceIDSource = "hostname"
+)
+
+const (
+	// ServiceInstanceIDEnvKey is the environment variable injecting the service.instance.id,
+	// it takes precedence over the generated id.
+	ServiceInstanceIDEnvKey = "OTEL_SERVICE_INSTANCE_ID"
+
+	// InstanceIDSourceEnvKey is the environment variable selecting how the
+	// service.instance.id is generated: random, pod_uid or hostname.
+	InstanceIDSourceEnvKey = "METRICS_SERVICE_INSTANCE_ID_SOURCE"
+)
+
+type (
+	// InstanceIDSource defines how the service.instance.id is generated.
+	InstanceIDSource string
+
+	// instanceIDDetector detects the service.instance.id.
+	instanceIDDetector struct {
+		source InstanceIDSource
+		// optional adds nothing when neither environment variable is set.
+		optional bool
+	}
+)
+
+var (
+	processInstanceID     string
+	processInstanceIDOnce sync.Once
+)
+
+// ServiceInstanceID returns a detector adding a stable service.instance.id to the
+// resource, which several backends require to aggregate per instance. The id is
+// read from OTEL_SERVICE_INSTANCE_ID when set, generated from the source otherwise.
+//
+// Parameters:
+//   - source: How the id is generated, InstanceIDRandom if empty or unknown
+//
+// Returns:
+//   - A resource.Detector adding service.instance.id
+func ServiceInstanceID(source InstanceIDSource) resource.Detector {
+	return instanceIDDetector{source: source}
+}
+
+// ServiceInstanceIDFromEnv returns the ServiceInstanceID detector configured by the
+// METRICS_SERVICE_INSTANCE_ID_SOURCE environment variable. Nothing is added when
+// neither it nor OTEL_SERVICE_INSTANCE_ID is set.
+//
+// Returns:
+//   - A resource.Detector adding service.instance.id when configured
+func ServiceInstanceIDFromEnv() resource.Detector {
+	source := strings.ToLower(strings.TrimSpace(os.Getenv(InstanceIDSourceEnvKey)))
+	return instanceIDDetector{source: InstanceIDSource(source), optional: true}
+}
+
+// Detect returns a resource holding the service.instance.id.
+func (d instanceIDDetector) Detect(context.Context) (*resource.Resource, error) {
+	if d.optional && d.source == "" && os.Getenv(ServiceInstanceIDEnvKey) == "" {
+		return resource.Empty(), nil
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceInstanceID(d.instanceID())), nil
+}
+
+// instanceID returns the injected id or the id generated from the source.
+func (d instanceIDDetector) instanceID() string {
+	if id := strings.TrimSpace(os.Getenv(ServiceInstanceIDEnvKey)); id != "" {
+		return id
+	}
+
+	switch d.source {
+	case InstanceIDPodUID:
+		if uid := podUID(); uid != "" {
+			return uid
+		}
+	case InstanceIDHostname:
+		if host, err := os.Hostname(); err == nil && host != "" {
+			return host
+		}
+	}
+
+	processInstanceIDOnce.Do(func() {
+		processInstanceID = newUUID()
+	})
+	return processInstanceID
+}
+
+// podUID returns the Kubernetes pod uid, empty outside of Kubernetes.
+func podUID() string {
+	for _, src := range kubernetesSources {
+		if src.key == semconv.K8SPodUIDKey {
+			return src.lookup(PodInfoDir())
+		}
+	}
+	return ""
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
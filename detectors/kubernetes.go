@@ -0,0 +1,100 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package detectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+// DefaultPodInfoDir is the default mount path of the downward API volume read by
+// the Kubernetes detector.
+const DefaultPodInfoDir = "/etc/podinfo"
+
+// PodInfoDirEnvKey is the environment variable overriding the downward API volume path.
+const PodInfoDirEnvKey = "K8S_PODINFO_DIR"
+
+type (
+	// kubernetesDetector detects Kubernetes resource attributes from the downward API.
+	kubernetesDetector struct{}
+
+	// kubernetesSource describes where a Kubernetes attribute can be found:
+	// the first non-empty environment variable, then the downward API volume file.
+	kubernetesSource struct {
+		key  attribute.Key
+		envs []string
+		file string
+	}
+)
+
+// kubernetesSources lists the attributes detected and the standard downward API
+// environment variables and file names holding them.
+var kubernetesSources = []kubernetesSource{
+	{semconv.K8SPodNameKey, []string{"K8S_POD_NAME", "POD_NAME"}, "name"},
+	{semconv.K8SPodUIDKey, []string{"K8S_POD_UID", "POD_UID"}, "uid"},
+	{semconv.K8SNamespaceNameKey, []string{"K8S_NAMESPACE_NAME", "K8S_NAMESPACE", "POD_NAMESPACE"}, "namespace"},
+	{semconv.K8SNodeNameKey, []string{"K8S_NODE_NAME", "NODE_NAME"}, "node_name"},
+	{semconv.K8SContainerNameKey, []string{"K8S_CONTAINER_NAME", "CONTAINER_NAME"}, "container_name"},
+}
+
+// Kubernetes returns a detector adding the pod name, pod uid, namespace, node name
+// and container name resource attributes. Values are read from the standard downward
+// API environment variables (K8S_POD_NAME, POD_NAMESPACE, NODE_NAME, ...) and, when
+// missing, from the files of the downward API volume mounted at /etc/podinfo or at
+// the K8S_PODINFO_DIR path. Nothing is added outside of Kubernetes.
+//
+// Returns:
+//   - A resource.Detector reading the Kubernetes downward API
+func Kubernetes() resource.Detector {
+	return kubernetesDetector{}
+}
+
+// Detect reads the downward API environment variables and files into a resource.
+func (kubernetesDetector) Detect(context.Context) (*resource.Resource, error) {
+	dir := PodInfoDir()
+
+	var attrs []attribute.KeyValue
+	for _, src := range kubernetesSources {
+		if v := src.lookup(dir); v != "" {
+			attrs = append(attrs, src.key.String(v))
+		}
+	}
+
+	if len(attrs) == 0 {
+		return resource.Empty(), nil
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+// PodInfoDir returns the path of the downward API volume, K8S_PODINFO_DIR or DefaultPodInfoDir.
+func PodInfoDir() string {
+	if dir := os.Getenv(PodInfoDirEnvKey); dir != "" {
+		return dir
+	}
+	return DefaultPodInfoDir
+}
+
+// lookup returns the first non-empty environment variable, or the content of the
+// downward API file if none is set.
+func (s kubernetesSource) lookup(dir string) string {
+	for _, env := range s.envs {
+		if v := strings.TrimSpace(os.Getenv(env)); v != "" {
+			return v
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, s.file))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
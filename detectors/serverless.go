@@ -0,0 +1,109 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package detectors
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+)
+
+// serverlessDetector detects serverless platforms resource attributes from their
+// runtime environment variables.
+type serverlessDetector struct{}
+
+// Serverless returns a detector adding the faas.* and cloud.* resource attributes on
+// AWS Lambda, Google Cloud Run, Google Cloud Functions and Azure Functions, from the
+// environment variables set by each platform runtime. Nothing is added elsewhere.
+//
+// Returns:
+//   - A resource.Detector identifying serverless platforms
+func Serverless() resource.Detector {
+	return serverlessDetector{}
+}
+
+// Detect reads the serverless platforms environment variables into a resource.
+func (serverlessDetector) Detect(context.Context) (*resource.Resource, error) {
+	var attrs []attribute.KeyValue
+
+	switch {
+	case os.Getenv("AWS_LAMBDA_FUNCTION_NAME") != "":
+		attrs = append(attrs,
+			semconv.CloudProviderAWS,
+			semconv.CloudPlatformAWSLambda,
+			semconv.FaaSName(os.Getenv("AWS_LAMBDA_FUNCTION_NAME")),
+		)
+		attrs = appendEnv(attrs, semconv.FaaSVersionKey, "AWS_LAMBDA_FUNCTION_VERSION")
+		attrs = appendEnv(attrs, semconv.FaaSInstanceKey, "AWS_LAMBDA_LOG_STREAM_NAME")
+		attrs = appendEnv(attrs, semconv.CloudRegionKey, "AWS_REGION")
+		attrs = appendMemoryMB(attrs, "AWS_LAMBDA_FUNCTION_MEMORY_SIZE")
+
+	case os.Getenv("K_SERVICE") != "" && os.Getenv("FUNCTION_TARGET") != "":
+		// Cloud Functions (2nd gen) run on Cloud Run and also set FUNCTION_TARGET
+		attrs = append(attrs,
+			semconv.CloudProviderGCP,
+			semconv.CloudPlatformGCPCloudFunctions,
+			semconv.FaaSName(os.Getenv("K_SERVICE")),
+		)
+		attrs = appendEnv(attrs, semconv.FaaSVersionKey, "K_REVISION")
+
+	case os.Getenv("K_SERVICE") != "":
+		attrs = append(attrs,
+			semconv.CloudProviderGCP,
+			semconv.CloudPlatformGCPCloudRun,
+			semconv.FaaSName(os.Getenv("K_SERVICE")),
+		)
+		attrs = appendEnv(attrs, semconv.FaaSVersionKey, "K_REVISION")
+
+	case os.Getenv("FUNCTION_NAME") != "" && os.Getenv("FUNCTION_REGION") != "":
+		// Cloud Functions (1st gen) legacy runtimes
+		attrs = append(attrs,
+			semconv.CloudProviderGCP,
+			semconv.CloudPlatformGCPCloudFunctions,
+			semconv.FaaSName(os.Getenv("FUNCTION_NAME")),
+			semconv.CloudRegion(os.Getenv("FUNCTION_REGION")),
+		)
+		attrs = appendEnv(attrs, semconv.FaaSVersionKey, "X_GOOGLE_FUNCTION_VERSION")
+		attrs = appendMemoryMB(attrs, "FUNCTION_MEMORY_MB")
+
+	case os.Getenv("FUNCTIONS_WORKER_RUNTIME") != "" && os.Getenv("WEBSITE_SITE_NAME") != "":
+		attrs = append(attrs,
+			semconv.CloudProviderAzure,
+			semconv.CloudPlatformAzureFunctions,
+			semconv.FaaSName(os.Getenv("WEBSITE_SITE_NAME")),
+		)
+		attrs = appendEnv(attrs, semconv.FaaSVersionKey, "FUNCTIONS_EXTENSION_VERSION")
+		attrs = appendEnv(attrs, semconv.FaaSInstanceKey, "WEBSITE_INSTANCE_ID")
+		attrs = appendEnv(attrs, semconv.CloudRegionKey, "REGION_NAME")
+		attrs = appendMemoryMB(attrs, "WEBSITE_MEMORY_LIMIT_MB")
+	}
+
+	if len(attrs) == 0 {
+		return resource.Empty(), nil
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+// appendEnv appends the attribute with the value of the environment variable, if set.
+func appendEnv(attrs []attribute.KeyValue, key attribute.Key, env string) []attribute.KeyValue {
+	if v := os.Getenv(env); v != "" {
+		attrs = append(attrs, key.String(v))
+	}
+	return attrs
+}
+
+// appendMemoryMB appends faas.max_memory, in bytes, from the environment variable
+// holding the memory limit in megabytes, if set and valid.
+func appendMemoryMB(attrs []attribute.KeyValue, env string) []attribute.KeyValue {
+	if mb, err := strconv.Atoi(os.Getenv(env)); err == nil && mb > 0 {
+		attrs = append(attrs, semconv.FaaSMaxMemory(mb*1024*1024))
+	}
+	return attrs
+}
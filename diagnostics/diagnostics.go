@@ -0,0 +1,250 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package diagnostics provides a live inspection endpoint, in the spirit of the gops
+// agent, exposing in human-readable form a snapshot of the current instrument values,
+// the Go runtime statistics and the exporter status. It helps operators logged on a
+// host without access to the metrics backend.
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// Inspector collects the snapshots served by the diagnostics endpoint. It must
+	// be registered in the MeterProvider with the options returned by Options.
+	Inspector struct {
+		reader *sdkmetric.ManualReader
+		start  time.Time
+
+		mu       sync.Mutex
+		exports  uint64
+		failures uint64
+		last     time.Time
+		lastErr  error
+	}
+
+	// statusExporter records the status of the exports of the wrapped exporter.
+	statusExporter struct {
+		sdkmetric.Exporter
+		inspector *Inspector
+	}
+)
+
+// New creates an Inspector.
+//
+// Returns:
+//   - A new Inspector
+func New() *Inspector {
+	return &Inspector{reader: sdkmetric.NewManualReader(), start: time.Now()}
+}
+
+// Options returns the Install options registering the inspector reader and the
+// exporter wrapper recording the exporter status.
+//
+// Returns:
+//   - The options to pass to metrics.Install
+func (i *Inspector) Options() []options.Option {
+	return []options.Option{
+		options.WithReaders(i.reader),
+		options.WithExporterWrapper(func(exp sdkmetric.Exporter) sdkmetric.Exporter {
+			return &statusExporter{Exporter: exp, inspector: i}
+		}),
+	}
+}
+
+// Handler returns the HTTP handler serving the snapshot as plain text. It must only
+// be exposed on an administrative listener, such as localhost.
+func (i *Inspector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := i.WriteSnapshot(r.Context(), w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// WriteSnapshot writes the exporter status, the runtime statistics and the current
+// instrument values to w.
+//
+// Parameters:
+//   - ctx: The context of the collection
+//   - w: The writer receiving the snapshot
+//
+// Returns:
+//   - An error if the metrics cannot be collected or the snapshot cannot be written
+func (i *Inspector) WriteSnapshot(ctx context.Context, w io.Writer) error {
+	var rm metricdata.ResourceMetrics
+	if err := i.reader.Collect(ctx, &rm); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	i.writeExporter(tw)
+	writeRuntime(tw, time.Since(i.start))
+	writeInstruments(tw, &rm)
+
+	return tw.Flush()
+}
+
+// Export records the status of the export.
+func (e *statusExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	err := e.Exporter.Export(ctx, rm)
+
+	e.inspector.mu.Lock()
+	e.inspector.exports++
+	e.inspector.last = time.Now()
+	if err != nil {
+		e.inspector.failures++
+		e.inspector.lastErr = err
+	}
+	e.inspector.mu.Unlock()
+
+	return err
+}
+
+// writeExporter writes the exporter status section.
+func (i *Inspector) writeExporter(w io.Writer) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	fmt.Fprintln(w, "== Exporter ==")
+	fmt.Fprintf(w, "exports:\t%d\n", i.exports)
+	fmt.Fprintf(w, "failures:\t%d\n", i.failures)
+	if i.last.IsZero() {
+		fmt.Fprintln(w, "last export:\tnever")
+	} else {
+		fmt.Fprintf(w, "last export:\t%s (%s ago)\n", i.last.Format(time.RFC3339), time.Since(i.last).Truncate(time.Second))
+	}
+	if i.lastErr != nil {
+		fmt.Fprintf(w, "last error:\t%v\n", i.lastErr)
+	}
+	fmt.Fprintln(w)
+}
+
+// writeRuntime writes the Go runtime statistics section.
+func writeRuntime(w io.Writer, uptime time.Duration) {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	fmt.Fprintln(w, "== Runtime ==")
+	fmt.Fprintf(w, "go version:\t%s\n", runtime.Version())
+	fmt.Fprintf(w, "uptime:\t%s\n", uptime.Truncate(time.Second))
+	fmt.Fprintf(w, "goroutines:\t%d\n", runtime.NumGoroutine())
+	fmt.Fprintf(w, "GOMAXPROCS:\t%d\n", runtime.GOMAXPROCS(0))
+	fmt.Fprintf(w, "heap alloc:\t%d bytes\n", stats.HeapAlloc)
+	fmt.Fprintf(w, "sys:\t%d bytes\n", stats.Sys)
+	fmt.Fprintf(w, "gc cycles:\t%d\n", stats.NumGC)
+	fmt.Fprintf(w, "last gc pause:\t%s\n", time.Duration(stats.PauseNs[(stats.NumGC+255)%256]))
+	fmt.Fprintln(w)
+}
+
+// writeInstruments writes the current values of the instruments, by scope.
+func writeInstruments(w io.Writer, rm *metricdata.ResourceMetrics) {
+	fmt.Fprintln(w, "== Instruments ==")
+
+	for _, sm := range rm.ScopeMetrics {
+		fmt.Fprintf(w, "[%s]\n", sm.Scope.Name)
+
+		metrics := append([]metricdata.Metrics(nil), sm.Metrics...)
+		sort.Slice(metrics, func(a, b int) bool { return metrics[a].Name < metrics[b].Name })
+
+		for _, m := range metrics {
+			unit := ""
+			if m.Unit != "" {
+				unit = " (" + m.Unit + ")"
+			}
+			fmt.Fprintf(w, "%s%s\n", m.Name, unit)
+
+			for _, line := range dataPointLines(m.Data) {
+				fmt.Fprintf(w, "  %s\n", line)
+			}
+		}
+	}
+}
+
+// dataPointLines formats the data points of the aggregation, sorted by attributes.
+func dataPointLines(data metricdata.Aggregation) []string {
+	var lines []string
+
+	switch a := data.(type) {
+	case metricdata.Sum[int64]:
+		lines = valueLines(a.DataPoints)
+	case metricdata.Sum[float64]:
+		lines = valueLines(a.DataPoints)
+	case metricdata.Gauge[int64]:
+		lines = valueLines(a.DataPoints)
+	case metricdata.Gauge[float64]:
+		lines = valueLines(a.DataPoints)
+	case metricdata.Histogram[int64]:
+		lines = histogramLines(a.DataPoints)
+	case metricdata.Histogram[float64]:
+		lines = histogramLines(a.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		for _, dp := range a.DataPoints {
+			lines = append(lines, fmt.Sprintf("%s\tcount=%d sum=%v", formatAttributes(dp.Attributes), dp.Count, dp.Sum))
+		}
+	case metricdata.ExponentialHistogram[float64]:
+		for _, dp := range a.DataPoints {
+			lines = append(lines, fmt.Sprintf("%s\tcount=%d sum=%v", formatAttributes(dp.Attributes), dp.Count, dp.Sum))
+		}
+	case metricdata.Summary:
+		for _, dp := range a.DataPoints {
+			lines = append(lines, fmt.Sprintf("%s\tcount=%d sum=%v", formatAttributes(dp.Attributes), dp.Count, dp.Sum))
+		}
+	}
+
+	sort.Strings(lines)
+	return lines
+}
+
+// valueLines formats the sum and gauge data points.
+func valueLines[N int64 | float64](dps []metricdata.DataPoint[N]) []string {
+	lines := make([]string, 0, len(dps))
+	for _, dp := range dps {
+		lines = append(lines, fmt.Sprintf("%s\t%v", formatAttributes(dp.Attributes), dp.Value))
+	}
+	return lines
+}
+
+// histogramLines formats the histogram data points.
+func histogramLines[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []string {
+	lines := make([]string, 0, len(dps))
+	for _, dp := range dps {
+		line := fmt.Sprintf("%s\tcount=%d sum=%v", formatAttributes(dp.Attributes), dp.Count, dp.Sum)
+		if v, ok := dp.Min.Value(); ok {
+			line += fmt.Sprintf(" min=%v", v)
+		}
+		if v, ok := dp.Max.Value(); ok {
+			line += fmt.Sprintf(" max=%v", v)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// formatAttributes formats the attributes as {k=v, ...}.
+func formatAttributes(set attribute.Set) string {
+	kvs := set.ToSlice()
+	parts := make([]string, 0, len(kvs))
+	for _, kv := range kvs {
+		parts = append(parts, string(kv.Key)+"="+kv.Value.Emit())
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package diagnostics
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PprofPath is the path under which PprofHandler is expected to be mounted.
+const PprofPath = "/debug/pprof/"
+
+// maxCPUProfileDuration bounds the duration of a CPU profile.
+const maxCPUProfileDuration = time.Minute
+
+// PprofHandler returns the handler serving the runtime profiles under PprofPath:
+// the index, the named profiles such as heap, allocs or goroutine, and the CPU
+// profile at profile?seconds=N. Unlike net/http/pprof, nothing is registered in
+// http.DefaultServeMux. It must only be exposed on an administrative listener.
+func PprofHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, PprofPath)
+		switch name {
+		case "":
+			writePprofIndex(w)
+		case "profile":
+			writeCPUProfile(w, r)
+		default:
+			writeProfile(w, r, name)
+		}
+	})
+}
+
+// writePprofIndex lists the available profiles.
+func writePprofIndex(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "%sprofile?seconds=30\n", PprofPath)
+	for _, p := range pprof.Profiles() {
+		fmt.Fprintf(w, "%s%s\t%d\n", PprofPath, p.Name(), p.Count())
+	}
+}
+
+// writeProfile writes the named profile, as text with debug=1 or 2.
+func writeProfile(w http.ResponseWriter, r *http.Request, name string) {
+	p := pprof.Lookup(name)
+	if p == nil {
+		http.Error(w, "unknown profile "+name, http.StatusNotFound)
+		return
+	}
+
+	debug, _ := strconv.Atoi(r.URL.Query().Get("debug"))
+	if debug > 0 {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	}
+
+	if err := p.WriteTo(w, debug); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// writeCPUProfile profiles the CPU for the requested seconds, 30 by default.
+func writeCPUProfile(w http.ResponseWriter, r *http.Request) {
+	seconds, err := strconv.Atoi(r.URL.Query().Get("seconds"))
+	if err != nil || seconds <= 0 {
+		seconds = 30
+	}
+	duration := min(time.Duration(seconds)*time.Second, maxCPUProfileDuration)
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile"`)
+	if err := pprof.StartCPUProfile(w); err != nil {
+		// A CPU profile is already running
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	select {
+	case <-time.After(duration):
+	case <-r.Context().Done():
+	}
+	pprof.StopCPUProfile()
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/goxkit/metrics/sanitize"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// hllPrecision is the number of index bits of the HyperLogLog sketches: 4096 one-byte
+// registers per attribute set, for a standard error of about 1.6%.
+const hllPrecision = 12
+
+type (
+	// Distinct counts the distinct values, such as user IDs or client IPs, seen
+	// during each collection interval with HyperLogLog sketches, and exports the
+	// approximate unique count as a gauge per attribute set. The sketches are reset on
+	// every collection of the exporting reader, see WindowProducer, so the gauge
+	// reports the unique values of the last export interval.
+	//
+	// A sketch takes 4KiB per attribute set whatever the number of values. A
+	// Distinct is safe for concurrent use.
+	Distinct struct {
+		mu       sync.Mutex
+		sketches map[attribute.Distinct]*hllSketch
+
+		registration metric.Registration
+	}
+
+	// hllSketch is the HyperLogLog sketch of an attribute set.
+	hllSketch struct {
+		set       attribute.Set
+		registers [1 << hllPrecision]uint8
+	}
+)
+
+var _ Aggregation = (*Distinct)(nil)
+
+// DistinctCounter creates a Distinct counter exported as the gauge with the given name.
+//
+// Parameters:
+//   - name: The gauge name, such as "users.active"
+//   - opts: The gauge options, such as the description
+//
+// Returns:
+//   - A new Distinct counter
+//   - An error if the gauge cannot be registered
+func (r *Recorder) DistinctCounter(name string, opts ...metric.Float64ObservableGaugeOption) (*Distinct, error) {
+	c := &Distinct{sketches: map[attribute.Distinct]*hllSketch{}}
+
+	registration, err := r.RegisterAggregation(name, c, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.registration = registration
+
+	return c, nil
+}
+
+// DistinctCounter creates a Distinct counter exported as the named gauge using the default Recorder.
+func DistinctCounter(name string, opts ...metric.Float64ObservableGaugeOption) (*Distinct, error) {
+	return defaultRecorder.DistinctCounter(name, opts...)
+}
+
+// Add adds a value to the distinct values of the attribute set.
+//
+// Parameters:
+//   - value: The value, such as a user ID
+//   - attrs: The attributes of the measurement
+func (c *Distinct) Add(value string, attrs ...attribute.KeyValue) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(value))
+	hash := mix64(h.Sum64())
+
+	index := hash >> (64 - hllPrecision)
+	rank := uint8(min(bits.LeadingZeros64(hash<<hllPrecision)+1, 64-hllPrecision+1))
+
+	set := attribute.NewSet(sanitize.Attributes(attrs)...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sketch, ok := c.sketches[set.Equivalent()]
+	if !ok {
+		sketch = &hllSketch{set: set}
+		c.sketches[set.Equivalent()] = sketch
+	}
+	if rank > sketch.registers[index] {
+		sketch.registers[index] = rank
+	}
+}
+
+// Collect reports the estimated distinct count of every attribute set, then resets the
+// sketches. It is called by the collections of the exporting reader.
+func (c *Distinct) Collect(report func(value float64, opts ...metric.ObserveOption)) {
+	c.mu.Lock()
+	sketches := c.sketches
+	c.sketches = make(map[attribute.Distinct]*hllSketch, len(sketches))
+	c.mu.Unlock()
+
+	for _, sketch := range sketches {
+		report(sketch.estimate(), metric.WithAttributeSet(sketch.set))
+	}
+}
+
+// Unregister stops exporting the counter.
+//
+// Returns:
+//   - An error if the callback cannot be unregistered
+func (c *Distinct) Unregister() error {
+	return c.registration.Unregister()
+}
+
+// estimate returns the HyperLogLog estimate of the distinct count, with the linear
+// counting correction of the small cardinalities.
+func (s *hllSketch) estimate() float64 {
+	const m = float64(1 << hllPrecision)
+
+	sum, zeros := 0.0, 0
+	for _, r := range s.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return math.Round(estimate)
+}
+
+// mix64 is the splitmix64 finalizer, spreading the FNV hash bits evenly.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// Nanoseconds records durations in nanoseconds.
+	Nanoseconds Unit = "ns"
+	// Microseconds records durations in microseconds.
+	Microseconds Unit = "us"
+	// Milliseconds records durations in milliseconds.
+	Milliseconds Unit = "ms"
+	// Seconds records durations in seconds, the OpenTelemetry semantic conventions default.
+	Seconds Unit = "s"
+)
+
+// Unit is the UCUM unit in which a duration is recorded.
+type Unit string
+
+// Convert converts d to the unit.
+//
+// Parameters:
+//   - d: The duration to convert
+//
+// Returns:
+//   - The duration expressed in the unit, in seconds for an unknown unit
+func (u Unit) Convert(d time.Duration) float64 {
+	switch u {
+	case Nanoseconds:
+		return float64(d)
+	case Microseconds:
+		return float64(d) / float64(time.Microsecond)
+	case Milliseconds:
+		return float64(d) / float64(time.Millisecond)
+	default:
+		return d.Seconds()
+	}
+}
+
+// RecordDuration records d in the histogram with the given name, converted to unit.
+// The histogram is created with the unit set, so the recorded values and the unit
+// exported to the backend always agree. A name is bound to the unit of its first
+// recording: the recordings with another unit are dropped and reported to the
+// OpenTelemetry error handler wrapping ErrInvalidInstrumentation, like the instrument
+// creation errors.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - name: The histogram name
+//   - d: The duration to record
+//   - unit: The unit of the recorded values, Seconds if empty
+//   - attrs: The attributes of the measurement
+func (r *Recorder) RecordDuration(ctx context.Context, name string, d time.Duration, unit Unit, attrs ...attribute.KeyValue) {
+	if unit == "" {
+		unit = Seconds
+	}
+
+	if previous, loaded := r.durationUnits.LoadOrStore(name, unit); loaded && previous != unit {
+		otel.Handle(fmt.Errorf("%w: duration histogram %q recorded in %s, not %s", ErrInvalidInstrumentation, name, previous, unit))
+		return
+	}
+
+	histogram, err := cachedInstrument(r, "duration:"+name+":"+string(unit), func() (metric.Float64Histogram, error) {
+		return r.meter.Float64Histogram(name, r.histogramOptions(name, unit.duration(), metric.WithUnit(string(unit)))...)
+	})
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	histogram.Record(ctx, unit.Convert(d), metric.WithAttributes(r.attributes(ctx, attrs)...))
+}
+
+// RecordDuration records d in the named histogram, converted to unit, using the default Recorder.
+func RecordDuration(ctx context.Context, name string, d time.Duration, unit Unit, attrs ...attribute.KeyValue) {
+	defaultRecorder.RecordDuration(ctx, name, d, unit, attrs...)
+}
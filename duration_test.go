@@ -0,0 +1,51 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestRecordDurationUnitConflict verifies that a duration histogram keeps the unit of
+// its first recording and that the recordings with another unit are reported.
+func TestRecordDurationUnitConflict(t *testing.T) {
+	var reported []error
+	previous := otel.GetErrorHandler()
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { reported = append(reported, err) }))
+	t.Cleanup(func() { otel.SetErrorHandler(previous) })
+
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	r := NewRecorder(provider.Meter("test"))
+	r.RecordDuration(ctx, "job.duration", time.Second, Milliseconds)
+	r.RecordDuration(ctx, "job.duration", time.Second, Seconds)
+	r.RecordDuration(ctx, "job.duration", time.Second, Milliseconds)
+
+	if len(reported) != 1 || !errors.Is(reported[0], ErrInvalidInstrumentation) {
+		t.Fatalf("got reported errors %v, want one ErrInvalidInstrumentation", reported)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+	metrics := rm.ScopeMetrics[0].Metrics
+	if len(metrics) != 1 || metrics[0].Unit != "ms" {
+		t.Fatalf("got metrics %+v, want a single histogram in ms", metrics)
+	}
+	if dp := metrics[0].Data.(metricdata.Histogram[float64]).DataPoints[0]; dp.Count != 2 || dp.Sum != 2000 {
+		t.Errorf("got %d values summing to %v, want the 2 recordings in ms", dp.Count, dp.Sum)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package errs defines the sentinel errors returned by the metrics Install functions,
+// allowing callers to branch on the failure cause with errors.Is. The errors are
+// re-exported by the metrics package.
+package errs
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidConfig is returned when the configuration is missing required settings.
+	ErrInvalidConfig = errors.New("metrics: invalid configuration")
+
+	// ErrExporterUnavailable is returned when the metrics exporter or its connection
+	// cannot be created.
+	ErrExporterUnavailable = errors.New("metrics: exporter unavailable")
+
+	// ErrAlreadyInstalled is returned when a MeterProvider is already installed in the
+	// configuration. It must be shut down before installing a new one.
+	ErrAlreadyInstalled = errors.New("metrics: provider already installed")
+
+	// ErrInvalidInstrumentation is reported to the OpenTelemetry error handler by the
+	// noop validate mode when an instrumentation bug is detected, and by RecordDuration
+	// when a histogram is recorded with two units.
+	ErrInvalidInstrumentation = errors.New("metrics: invalid instrumentation")
+)
@@ -0,0 +1,138 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrInvalidEvent is returned by RegisterEvents for an invalid event schema, and reported
+// to the OpenTelemetry error handler when an event doesn't match its registered schema.
+var ErrInvalidEvent = errors.New("metrics: invalid event")
+
+// EventSchema describes a product analytics event counted by Event: its name and the
+// attributes it may carry with their types. Attributes are optional, but an event with
+// an attribute outside its schema, or of another type, is rejected.
+type EventSchema struct {
+	// Name is the event name, also the name of its counter, such as "checkout.completed".
+	Name string
+	// Description is the description of the event counter.
+	Description string
+	// Attributes maps the allowed attribute keys to their type.
+	Attributes map[attribute.Key]attribute.Type
+}
+
+var (
+	// eventsMu guards eventSchemas.
+	eventsMu sync.RWMutex
+	// eventSchemas holds the registered event schemas by name.
+	eventSchemas = map[string]EventSchema{}
+)
+
+// RegisterEvents registers the event schemas, usually at startup so a malformed schema
+// fails fast. No schema is registered if one of them is invalid.
+//
+// Parameters:
+//   - schemas: The event schemas
+//
+// Returns:
+//   - An error wrapping ErrInvalidEvent if a schema is unnamed, has an attribute of an
+//     invalid type, or is already registered
+func RegisterEvents(schemas ...EventSchema) error {
+	eventsMu.Lock()
+	defer eventsMu.Unlock()
+
+	seen := map[string]struct{}{}
+	var errs []error
+	for _, schema := range schemas {
+		if schema.Name == "" {
+			errs = append(errs, fmt.Errorf("%w: unnamed event", ErrInvalidEvent))
+			continue
+		}
+
+		_, registered := eventSchemas[schema.Name]
+		_, duplicate := seen[schema.Name]
+		if registered || duplicate {
+			errs = append(errs, fmt.Errorf("%w: %s already registered", ErrInvalidEvent, schema.Name))
+		}
+		seen[schema.Name] = struct{}{}
+
+		for key, typ := range schema.Attributes {
+			if !key.Defined() || typ == attribute.INVALID {
+				errs = append(errs, fmt.Errorf("%w: %s has an invalid attribute %q", ErrInvalidEvent, schema.Name, key))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for _, schema := range schemas {
+		eventSchemas[schema.Name] = schema
+	}
+
+	return nil
+}
+
+// validateEvent checks the event attributes against its registered schema.
+func validateEvent(name string, attrs []attribute.KeyValue) (EventSchema, error) {
+	eventsMu.RLock()
+	schema, ok := eventSchemas[name]
+	eventsMu.RUnlock()
+
+	if !ok {
+		return schema, fmt.Errorf("%w: %s is not registered", ErrInvalidEvent, name)
+	}
+
+	for _, attr := range attrs {
+		typ, ok := schema.Attributes[attr.Key]
+		if !ok {
+			return schema, fmt.Errorf("%w: %s doesn't allow attribute %q", ErrInvalidEvent, name, attr.Key)
+		}
+		if attr.Value.Type() != typ {
+			return schema, fmt.Errorf("%w: %s attribute %q is %s, not %s", ErrInvalidEvent, name, attr.Key, attr.Value.Type(), typ)
+		}
+	}
+
+	return schema, nil
+}
+
+// Event counts an occurrence of the named event, registered with RegisterEvents. Events
+// that are not registered or don't match their schema are dropped and reported to the
+// OpenTelemetry error handler, so the exported series stay within the schema.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - name: The event name
+//   - attrs: The event attributes, allowed by its schema
+func (r *Recorder) Event(ctx context.Context, name string, attrs ...attribute.KeyValue) {
+	schema, err := validateEvent(name, attrs)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	counter, err := cachedInstrument(r, "event:"+name, func() (metric.Int64Counter, error) {
+		return r.meter.Int64Counter(name, metric.WithDescription(schema.Description), metric.WithUnit("{event}"))
+	})
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	counter.Add(ctx, 1, metric.WithAttributes(r.attributes(ctx, attrs)...))
+}
+
+// Event counts an occurrence of the named registered event using the default Recorder.
+func Event(ctx context.Context, name string, attrs ...attribute.KeyValue) {
+	defaultRecorder.Event(ctx, name, attrs...)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// DefaultMaxVariants is the default number of variants recorded per experiment.
+	DefaultMaxVariants = 10
+	// ExperimentVariantOther is the variant attribute of the variants beyond the cap.
+	ExperimentVariantOther = "other"
+)
+
+// Experiments records the exposures and outcomes of in-house A/B and multi-armed
+// experiments, for their analysis from the metrics backend:
+//   - experiment.exposures: counter of the exposures to a variant
+//   - experiment.outcomes: counter of the outcomes, such as conversions, by variant
+//
+// Both have the experiment and variant attributes. The variants of an experiment are
+// capped, those seen after the cap is reached are recorded as ExperimentVariantOther,
+// so a misconfigured experiment can't explode the cardinality.
+//
+// Experiments is safe for concurrent use.
+type Experiments struct {
+	exposures metric.Int64Counter
+	outcomes  metric.Int64Counter
+
+	maxVariants int
+
+	mu       sync.Mutex
+	variants map[string]map[string]struct{}
+}
+
+// NewExperiments creates the experiments metrics with instruments created by the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//   - maxVariants: The number of variants recorded per experiment, DefaultMaxVariants if not positive
+//
+// Returns:
+//   - A new Experiments
+//   - An error if the instruments cannot be created
+func NewExperiments(meter metric.Meter, maxVariants int) (*Experiments, error) {
+	if maxVariants <= 0 {
+		maxVariants = DefaultMaxVariants
+	}
+
+	exposures, err := meter.Int64Counter("experiment.exposures", metric.WithDescription("Exposures to an experiment variant."), metric.WithUnit("{exposure}"))
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes, err := meter.Int64Counter("experiment.outcomes", metric.WithDescription("Outcomes of an experiment, by variant."), metric.WithUnit("{outcome}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Experiments{
+		exposures:   exposures,
+		outcomes:    outcomes,
+		maxVariants: maxVariants,
+		variants:    map[string]map[string]struct{}{},
+	}, nil
+}
+
+// Expose records an exposure to the variant of the experiment.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - experiment: The experiment name
+//   - variant: The variant the subject was exposed to
+//   - attrs: Additional bounded attributes of the measurement
+func (e *Experiments) Expose(ctx context.Context, experiment, variant string, attrs ...attribute.KeyValue) {
+	e.exposures.Add(ctx, 1, metric.WithAttributes(e.attributes(experiment, variant, attrs)...))
+}
+
+// Outcome records an outcome, such as "conversion", of the variant of the experiment.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - experiment: The experiment name
+//   - variant: The variant the subject was exposed to
+//   - outcome: The outcome name
+//   - attrs: Additional bounded attributes of the measurement
+func (e *Experiments) Outcome(ctx context.Context, experiment, variant, outcome string, attrs ...attribute.KeyValue) {
+	attrs = append(attrs[:len(attrs):len(attrs)], attribute.String("outcome", outcome))
+	e.outcomes.Add(ctx, 1, metric.WithAttributes(e.attributes(experiment, variant, attrs)...))
+}
+
+// attributes returns the measurement attributes, with the variant capped.
+func (e *Experiments) attributes(experiment, variant string, attrs []attribute.KeyValue) []attribute.KeyValue {
+	return append(attrs[:len(attrs):len(attrs)],
+		attribute.String("experiment", experiment),
+		attribute.String("variant", e.capVariant(experiment, variant)),
+	)
+}
+
+// capVariant returns the variant, or ExperimentVariantOther once the experiment has
+// reached its variants cap.
+func (e *Experiments) capVariant(experiment, variant string) string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	seen, ok := e.variants[experiment]
+	if !ok {
+		seen = map[string]struct{}{}
+		e.variants[experiment] = seen
+	}
+
+	if _, ok := seen[variant]; ok {
+		return variant
+	}
+	if len(seen) >= e.maxVariants {
+		return ExperimentVariantOther
+	}
+
+	seen[variant] = struct{}{}
+	return variant
+}
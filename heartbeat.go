@@ -0,0 +1,127 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrInvalidHeartbeatInterval is returned when the heartbeat interval isn't positive.
+var ErrInvalidHeartbeatInterval = errors.New("metrics: heartbeat interval must be positive")
+
+// HeartbeatMonitor detects stalled loops, such as consumer workers. The loop calls
+// Beat on every iteration, the heartbeat.last_beat gauge reports the time of the
+// last beat and a background goroutine increments heartbeat.missed for every
+// interval elapsed without a beat.
+type HeartbeatMonitor struct {
+	interval time.Duration
+	attrs    metric.MeasurementOption
+
+	lastBeat     atomic.Int64
+	missed       metric.Int64Counter
+	registration metric.Registration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewHeartbeat creates a HeartbeatMonitor with instruments created by the given meter
+// and starts the goroutine checking for missed beats.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//   - name: The name of the monitored loop, reported as the heartbeat attribute
+//   - interval: The maximum expected time between two beats, it must be positive
+//
+// Returns:
+//   - A started HeartbeatMonitor
+//   - ErrInvalidHeartbeatInterval, or an error if the instruments cannot be created
+func NewHeartbeat(meter metric.Meter, name string, interval time.Duration) (*HeartbeatMonitor, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidHeartbeatInterval, interval)
+	}
+
+	lastBeat, err := meter.Float64ObservableGauge("heartbeat.last_beat", metric.WithDescription("Unix time of the last heartbeat."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	missed, err := meter.Int64Counter("heartbeat.missed", metric.WithDescription("Number of heartbeat intervals elapsed without a beat."))
+	if err != nil {
+		return nil, err
+	}
+
+	h := &HeartbeatMonitor{
+		interval: interval,
+		attrs:    metric.WithAttributes(attribute.String("heartbeat", name)),
+		missed:   missed,
+		stop:     make(chan struct{}),
+	}
+	h.lastBeat.Store(time.Now().UnixNano())
+
+	h.registration, err = meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		observer.ObserveFloat64(lastBeat, float64(h.lastBeat.Load())/float64(time.Second), h.attrs)
+		return nil
+	}, lastBeat)
+	if err != nil {
+		return nil, err
+	}
+
+	go h.watch()
+
+	return h, nil
+}
+
+// Beat records that the monitored loop is alive.
+func (h *HeartbeatMonitor) Beat() {
+	h.lastBeat.Store(time.Now().UnixNano())
+}
+
+// Stop stops the monitor, it no longer reports the last beat nor missed beats.
+func (h *HeartbeatMonitor) Stop() {
+	h.stopOnce.Do(func() {
+		close(h.stop)
+		_ = h.registration.Unregister()
+	})
+}
+
+// watch increments the missed counter for every interval elapsed without a beat.
+func (h *HeartbeatMonitor) watch() {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case now := <-ticker.C:
+			if now.Sub(time.Unix(0, h.lastBeat.Load())) > h.interval {
+				h.missed.Add(context.Background(), 1, h.attrs)
+			}
+		}
+	}
+}
+
+// Heartbeat creates a HeartbeatMonitor using the global MeterProvider.
+//
+// Parameters:
+//   - name: The name of the monitored loop, reported as the heartbeat attribute
+//   - interval: The maximum expected time between two beats, it must be positive
+//
+// Returns:
+//   - A started HeartbeatMonitor, the loop must call Beat on every iteration
+//   - ErrInvalidHeartbeatInterval, or an error if the instruments cannot be created
+func Heartbeat(name string, interval time.Duration) (*HeartbeatMonitor, error) {
+	return NewHeartbeat(otel.Meter(instrumentationScope), name, interval)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package aggregate totals the data points of the collected metrics, for the hooks and
+// producers computing a single value per metric, and turns the cumulative totals into
+// totals per export.
+package aggregate
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Kinds of the aggregated data.
+const (
+	KindSum Kind = iota + 1
+	KindGauge
+	KindHistogram
+)
+
+type (
+	// Kind is the kind of the aggregated data.
+	Kind int
+
+	// Totals are the totals of the data points of a metric.
+	Totals struct {
+		Kind        Kind
+		Temporality metricdata.Temporality
+		Monotonic   bool
+		// Points is the number of totaled data points.
+		Points int
+		// Value is the sum of the values of the sums and gauges.
+		Value float64
+		// Sum and Count are the sums of the histogram sums and counts.
+		Sum   float64
+		Count float64
+	}
+
+	// Deltas tracks the previous cumulative totals of the metrics, to compute their
+	// increase since the previous export. It is safe for concurrent use.
+	Deltas struct {
+		mu   sync.Mutex
+		prev map[string]Totals
+	}
+)
+
+// Total totals the data points of the metric data accepted by match, all data points
+// if match is nil.
+//
+// Parameters:
+//   - data: The metric data
+//   - match: The filter of the data point attributes
+//
+// Returns:
+//   - The totals of the matching data points
+//   - Whether data is a sum, a gauge or a histogram
+func Total(data metricdata.Aggregation, match func(attribute.Set) bool) (Totals, bool) {
+	switch a := data.(type) {
+	case metricdata.Sum[int64]:
+		return totalNumbers(KindSum, a.Temporality, a.IsMonotonic, a.DataPoints, match), true
+	case metricdata.Sum[float64]:
+		return totalNumbers(KindSum, a.Temporality, a.IsMonotonic, a.DataPoints, match), true
+	case metricdata.Gauge[int64]:
+		return totalNumbers(KindGauge, 0, false, a.DataPoints, match), true
+	case metricdata.Gauge[float64]:
+		return totalNumbers(KindGauge, 0, false, a.DataPoints, match), true
+	case metricdata.Histogram[int64]:
+		return totalHistograms(a.Temporality, a.DataPoints, match), true
+	case metricdata.Histogram[float64]:
+		return totalHistograms(a.Temporality, a.DataPoints, match), true
+	}
+	return Totals{}, false
+}
+
+// Add adds the totals of another metric with the same kind.
+//
+// Parameters:
+//   - o: The totals to add
+func (t *Totals) Add(o Totals) {
+	if t.Kind == 0 {
+		*t = o
+		return
+	}
+	t.Points += o.Points
+	t.Value += o.Value
+	t.Sum += o.Sum
+	t.Count += o.Count
+}
+
+// Reduce returns the single value of the totals: the sum of the values of the sums and
+// gauges, or the mean of the histogram values.
+//
+// Returns:
+//   - The value of the totals
+//   - Whether a data point, or a histogram value, was totaled
+func (t Totals) Reduce() (float64, bool) {
+	if t.Kind == KindHistogram {
+		if t.Count == 0 {
+			return 0, false
+		}
+		return t.Sum / t.Count, true
+	}
+	return t.Value, t.Points > 0
+}
+
+// NewDeltas creates an empty Deltas.
+//
+// Returns:
+//   - A new Deltas
+func NewDeltas() *Deltas {
+	return &Deltas{prev: map[string]Totals{}}
+}
+
+// Delta returns the totals since the previous export of the cumulative monotonic sums
+// and histograms tracked under key, and the other totals unchanged. It has no value on
+// the first export of a cumulative metric. A decrease is handled as a reset.
+//
+// Parameters:
+//   - key: The key identifying the tracked totals
+//   - t: The totals of the current export
+//
+// Returns:
+//   - The totals since the previous export
+//   - Whether the delta is known
+func (d *Deltas) Delta(key string, t Totals) (Totals, bool) {
+	cumulative := t.Temporality == metricdata.CumulativeTemporality
+	if !cumulative || t.Kind == KindGauge || t.Kind == KindSum && !t.Monotonic {
+		return t, true
+	}
+
+	d.mu.Lock()
+	prev, ok := d.prev[key]
+	d.prev[key] = t
+	d.mu.Unlock()
+
+	if !ok {
+		return Totals{}, false
+	}
+
+	switch {
+	case t.Kind == KindSum && t.Value >= prev.Value:
+		t.Value -= prev.Value
+	case t.Kind == KindHistogram && t.Count >= prev.Count:
+		t.Sum, t.Count = t.Sum-prev.Sum, t.Count-prev.Count
+	}
+	t.Temporality = metricdata.DeltaTemporality
+
+	return t, true
+}
+
+// totalNumbers totals the values of the matching data points.
+func totalNumbers[N int64 | float64](kind Kind, temporality metricdata.Temporality, monotonic bool, dps []metricdata.DataPoint[N], match func(attribute.Set) bool) Totals {
+	t := Totals{Kind: kind, Temporality: temporality, Monotonic: monotonic}
+	for _, dp := range dps {
+		if match == nil || match(dp.Attributes) {
+			t.Points++
+			t.Value += float64(dp.Value)
+		}
+	}
+	return t
+}
+
+// totalHistograms totals the sums and counts of the matching data points.
+func totalHistograms[N int64 | float64](temporality metricdata.Temporality, dps []metricdata.HistogramDataPoint[N], match func(attribute.Set) bool) Totals {
+	t := Totals{Kind: KindHistogram, Temporality: temporality}
+	for _, dp := range dps {
+		if match == nil || match(dp.Attributes) {
+			t.Points++
+			t.Sum += float64(dp.Sum)
+			t.Count += float64(dp.Count)
+		}
+	}
+	return t
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package attrcodec serializes the attribute sets to JSON keeping the attribute types,
+// for the metrics persisted to disk and read back.
+package attrcodec
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// KeyValue is the serialized form of an attribute, keeping its type.
+type KeyValue struct {
+	Key   string `json:"key"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// Encode converts the attribute set to its serialized form.
+//
+// Parameters:
+//   - set: The attributes to serialize
+//
+// Returns:
+//   - The serialized attributes
+func Encode(set attribute.Set) []KeyValue {
+	kvs := set.ToSlice()
+	attrs := make([]KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		attrs = append(attrs, KeyValue{Key: string(kv.Key), Type: kv.Value.Type().String(), Value: kv.Value.AsInterface()})
+	}
+	return attrs
+}
+
+// Decode converts the serialized attributes back, restoring their type. Slice
+// attributes are restored as strings.
+//
+// Parameters:
+//   - attrs: The serialized attributes, decoded from JSON
+//
+// Returns:
+//   - The attributes
+func Decode(attrs []KeyValue) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		key := attribute.Key(a.Key)
+		switch v := a.Value.(type) {
+		case bool:
+			kvs = append(kvs, key.Bool(v))
+		case float64:
+			// JSON numbers are decoded as float64, the type restores integers
+			if a.Type == attribute.INT64.String() {
+				kvs = append(kvs, key.Int64(int64(v)))
+			} else {
+				kvs = append(kvs, key.Float64(v))
+			}
+		case string:
+			kvs = append(kvs, key.String(v))
+		default:
+			kvs = append(kvs, key.String(fmt.Sprint(v)))
+		}
+	}
+	return kvs
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package window produces the metrics computed over the collection intervals of the
+// exporting reader, such as the distinct counts and the interval summaries. The
+// windows are not observable instruments, whose callbacks run on the collection of
+// every reader of the MeterProvider: they are collected and reset by the Producer,
+// registered in the exporting reader only, so the other readers, such as the
+// Prometheus handler or the diagnostics endpoint, don't shorten the exported windows.
+package window
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ErrDuplicate is returned when a window registers a metric name already registered
+// in its scope.
+var ErrDuplicate = errors.New("window: metric already registered")
+
+type (
+	// Collect returns the metrics of the window from start to now, then resets the
+	// window for the next interval.
+	Collect func(start, now time.Time) []metricdata.Metrics
+
+	// Producer produces the metrics of the registered windows. It is safe for
+	// concurrent use.
+	Producer struct {
+		mu      sync.Mutex
+		windows []*registration
+	}
+
+	// registration is a registered window and the end of its previous collection.
+	registration struct {
+		scope   string
+		names   []string
+		collect Collect
+		start   time.Time
+	}
+)
+
+var _ sdkmetric.Producer = (*Producer)(nil)
+
+// Default is the Producer of the windows of the package instruments, registered in
+// the exporting reader by options.NewReader.
+var Default = NewProducer()
+
+// NewProducer creates a Producer without windows.
+//
+// Returns:
+//   - A new Producer
+func NewProducer() *Producer {
+	return &Producer{}
+}
+
+// Register registers a window producing the named metrics in the instrumentation
+// scope.
+//
+// Parameters:
+//   - scope: The instrumentation scope of the metrics
+//   - names: The names of the metrics produced by the window
+//   - collect: The collection of the window
+//
+// Returns:
+//   - A function unregistering the window
+//   - ErrDuplicate if a name is already registered in the scope
+func (p *Producer) Register(scope string, names []string, collect Collect) (func(), error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, w := range p.windows {
+		if w.scope != scope {
+			continue
+		}
+		for _, name := range names {
+			for _, registered := range w.names {
+				if name == registered {
+					return nil, fmt.Errorf("%w: %s", ErrDuplicate, name)
+				}
+			}
+		}
+	}
+
+	w := &registration{scope: scope, names: names, collect: collect, start: time.Now()}
+	p.windows = append(p.windows, w)
+
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		for i, registered := range p.windows {
+			if registered == w {
+				p.windows = append(p.windows[:i], p.windows[i+1:]...)
+				return
+			}
+		}
+	}, nil
+}
+
+// Produce collects and resets the registered windows.
+//
+// Returns:
+//   - The metrics of the windows, grouped by instrumentation scope
+//   - Always nil, the collections cannot fail
+func (p *Producer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	now := time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var scopes []metricdata.ScopeMetrics
+	for _, w := range p.windows {
+		metrics := w.collect(w.start, now)
+		w.start = now
+		if len(metrics) == 0 {
+			continue
+		}
+
+		i := 0
+		for i < len(scopes) && scopes[i].Scope.Name != w.scope {
+			i++
+		}
+		if i == len(scopes) {
+			scopes = append(scopes, metricdata.ScopeMetrics{Scope: instrumentation.Scope{Name: w.scope}})
+		}
+		scopes[i].Metrics = append(scopes[i].Metrics, metrics...)
+	}
+
+	return scopes, nil
+}
+
+// Gauge returns the gauge metric with the data points of a window.
+//
+// Parameters:
+//   - name: The metric name
+//   - description: The metric description
+//   - unit: The metric unit
+//   - dps: The data points of the window
+//
+// Returns:
+//   - The gauge metric
+func Gauge[N int64 | float64](name, description, unit string, dps []metricdata.DataPoint[N]) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name:        name,
+		Description: description,
+		Unit:        unit,
+		Data:        metricdata.Gauge[N]{DataPoints: dps},
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+)
+
+// Common load shedding reasons, reported as the reason attribute.
+const (
+	// ShedReasonQueueFull is used when the work queue has no room left.
+	ShedReasonQueueFull = "queue_full"
+	// ShedReasonDeadline is used when the remaining deadline is too short to serve the request.
+	ShedReasonDeadline = "deadline"
+	// ShedReasonOverload is used when an overload controller rejects the request.
+	ShedReasonOverload = "overload"
+)
+
+// LoadShedder records the admission control decisions of the application: the
+// loadshed.admitted counter counts the accepted work and the loadshed.rejected
+// counter the shed work, tagged with the shed reason.
+//
+// A LoadShedder is safe for concurrent use.
+type LoadShedder struct {
+	admitted metric.Int64Counter
+	rejected metric.Int64Counter
+}
+
+var (
+	defaultLoadShedder     *LoadShedder
+	defaultLoadShedderErr  error
+	defaultLoadShedderOnce sync.Once
+)
+
+// NewLoadShedder creates a LoadShedder whose instruments are created with the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//
+// Returns:
+//   - A new LoadShedder
+//   - An error if the instruments cannot be created
+func NewLoadShedder(meter metric.Meter) (*LoadShedder, error) {
+	admitted, err := meter.Int64Counter("loadshed.admitted", metric.WithDescription("Number of work items admitted by the admission control."))
+	if err != nil {
+		return nil, err
+	}
+
+	rejected, err := meter.Int64Counter("loadshed.rejected", metric.WithDescription("Number of work items shed by the admission control, by reason."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadShedder{admitted: admitted, rejected: rejected}, nil
+}
+
+// Admit records an admitted work item.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - attrs: The attributes of the measurement, such as the endpoint
+func (l *LoadShedder) Admit(ctx context.Context, attrs ...attribute.KeyValue) {
+	l.admitted.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+// Shed records a shed work item with the given reason.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - reason: The shed reason, such as ShedReasonQueueFull
+//   - attrs: The attributes of the measurement, such as the endpoint
+func (l *LoadShedder) Shed(ctx context.Context, reason string, attrs ...attribute.KeyValue) {
+	set := attribute.NewSet(append(attrs[:len(attrs):len(attrs)], attribute.String("reason", reason))...)
+	l.rejected.Add(ctx, 1, metric.WithAttributeSet(set))
+}
+
+// CheckDeadline admits the work if the context deadline leaves at least minRemaining
+// to serve it, recording the decision. Contexts without deadline are admitted.
+//
+// Parameters:
+//   - ctx: The context of the work
+//   - minRemaining: The minimum time needed to serve the work
+//   - attrs: The attributes of the measurement
+//
+// Returns:
+//   - Whether the work is admitted
+func (l *LoadShedder) CheckDeadline(ctx context.Context, minRemaining time.Duration, attrs ...attribute.KeyValue) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < minRemaining {
+		l.Shed(ctx, ShedReasonDeadline, attrs...)
+		return false
+	}
+
+	l.Admit(ctx, attrs...)
+	return true
+}
+
+// TryEnqueue sends v to the queue without blocking, recording the decision:
+// admitted if sent, shed with ShedReasonQueueFull otherwise.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - l: The LoadShedder recording the decision
+//   - queue: The work queue
+//   - v: The work item
+//   - attrs: The attributes of the measurement
+//
+// Returns:
+//   - Whether the work item was enqueued
+func TryEnqueue[T any](ctx context.Context, l *LoadShedder, queue chan<- T, v T, attrs ...attribute.KeyValue) bool {
+	select {
+	case queue <- v:
+		l.Admit(ctx, attrs...)
+		return true
+	default:
+		l.Shed(ctx, ShedReasonQueueFull, attrs...)
+		return false
+	}
+}
+
+// DefaultLoadShedder returns the LoadShedder created on first use with the global
+// MeterProvider. Instrument creation errors are reported to the OpenTelemetry error
+// handler and a LoadShedder backed by the noop meter is returned.
+func DefaultLoadShedder() *LoadShedder {
+	defaultLoadShedderOnce.Do(func() {
+		defaultLoadShedder, defaultLoadShedderErr = NewLoadShedder(otel.Meter(instrumentationScope))
+		if defaultLoadShedderErr != nil {
+			otel.Handle(defaultLoadShedderErr)
+			defaultLoadShedder, _ = NewLoadShedder(metricnoop.NewMeterProvider().Meter(instrumentationScope))
+		}
+	})
+
+	return defaultLoadShedder
+}
+
+// RecordShed records a shed work item using the default LoadShedder.
+func RecordShed(ctx context.Context, reason string, attrs ...attribute.KeyValue) {
+	DefaultLoadShedder().Shed(ctx, reason, attrs...)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultMemoryLimitHeadroom is the fraction of the cgroup memory limit left to
+// non-heap memory, such as goroutine stacks and cgo allocations, when GOMEMLIMIT
+// is set with options.WithAutoMemoryLimit.
+const DefaultMemoryLimitHeadroom = 0.1
+
+// cgroupMemoryLimitPaths lists the memory limit locations of cgroup v2 and v1, in lookup order.
+var cgroupMemoryLimitPaths = []string{
+	"/sys/fs/cgroup/memory.max",
+	"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+}
+
+// memoryLimitOnce ensures GOMEMLIMIT is only set by the first Install.
+var memoryLimitOnce sync.Once
+
+// applyMemoryLimit sets, once per process, the Go memory limit to the cgroup memory
+// limit minus headroom and records the chosen value in the go.memory.limit.auto
+// gauge. An explicit GOMEMLIMIT environment variable always takes precedence.
+func applyMemoryLimit(meter metric.Meter, headroom float64) {
+	memoryLimitOnce.Do(func() {
+		if _, ok := os.LookupEnv("GOMEMLIMIT"); ok {
+			return
+		}
+
+		limit, ok := cgroupMemoryLimit()
+		if !ok {
+			return
+		}
+
+		if headroom <= 0 || headroom >= 1 {
+			headroom = DefaultMemoryLimitHeadroom
+		}
+		chosen := int64(float64(limit) * (1 - headroom))
+		debug.SetMemoryLimit(chosen)
+
+		gauge, err := meter.Int64Gauge("go.memory.limit.auto", metric.WithDescription("Go memory limit set from the cgroup memory limit."), metric.WithUnit("By"))
+		if err != nil {
+			otel.Handle(err)
+			return
+		}
+		gauge.Record(context.Background(), chosen)
+	})
+}
+
+// cgroupMemoryLimit reads the memory limit of the cgroup the process runs in.
+// It reports false outside of a cgroup or when the cgroup is unlimited.
+func cgroupMemoryLimit() (int64, bool) {
+	for _, path := range cgroupMemoryLimitPaths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		limit, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		// cgroup v2 reports "max" and cgroup v1 a page-aligned max int64 when unlimited
+		if err != nil || limit <= 0 || limit >= 1<<62 {
+			return 0, false
+		}
+		return limit, true
+	}
+
+	return 0, false
+}
@@ -11,26 +11,113 @@
 package metrics
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/errs"
 	"github.com/goxkit/metrics/noop"
+	"github.com/goxkit/metrics/options"
 	"github.com/goxkit/metrics/otlp"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
+var (
+	// ErrInvalidConfig is returned when the configuration is missing required settings.
+	ErrInvalidConfig = errs.ErrInvalidConfig
+
+	// ErrExporterUnavailable is returned when the metrics exporter or its connection
+	// cannot be created.
+	ErrExporterUnavailable = errs.ErrExporterUnavailable
+
+	// ErrAlreadyInstalled is returned when a MeterProvider is already installed in the
+	// configuration. Call Shutdown before installing a new one.
+	ErrAlreadyInstalled = errs.ErrAlreadyInstalled
+
+	// ErrInvalidInstrumentation is reported to the OpenTelemetry error handler when the
+	// noop validate mode detects an instrumentation bug, or when a duration histogram
+	// is recorded with two units.
+	ErrInvalidInstrumentation = errs.ErrInvalidInstrumentation
+)
+
 // Install initializes and configures a metric provider based on the application's configuration.
 // It determines whether to use the OpenTelemetry Protocol (OTLP) exporter or a no-operation
 // implementation depending on the configuration.
 //
+// The first successful Install of the process records the app.init.duration gauge,
+// the time elapsed since the process start; RecordColdStart counts the cold starts.
+// With options.WithAutoMemoryLimit, it also sets GOMEMLIMIT from the cgroup memory limit.
+// With options.WithEnvironmentProfile, the defaults of the profile of the application
+// environment, returned by ProfileFor, are applied first.
+// The collectors registered with Bind are bound to the new provider, and SelfTest
+// checks its exports.
+//
 // Parameters:
 //   - cfgs: Application configuration containing metrics settings
+//   - opts: Optional settings to customize the MeterProvider, such as views
 //
 // Returns:
 //   - A configured OpenTelemetry MeterProvider
-//   - An error if the initialization fails
-func Install(cfgs *configs.Configs) (*sdkmetric.MeterProvider, error) {
+//   - An error if the initialization fails, matching ErrInvalidConfig, ErrAlreadyInstalled
+//     or ErrExporterUnavailable with errors.Is
+func Install(cfgs *configs.Configs, opts ...options.Option) (*sdkmetric.MeterProvider, error) {
+	if cfgs == nil || cfgs.OTLPConfigs == nil {
+		return nil, fmt.Errorf("%w: nil OTLP configs", ErrInvalidConfig)
+	}
+
+	// Apply the defaults of the environment profile before selecting the exporter,
+	// the options given by the application take precedence
+	if options.New(opts...).EnvironmentProfile {
+		env := configs.UnknownEnv
+		if cfgs.AppConfigs != nil {
+			env = cfgs.AppConfigs.Environment
+		}
+		opts = append(ProfileFor(env).options(cfgs), opts...)
+	}
+
+	install := noop.Install
 	if cfgs.OTLPConfigs.Enabled {
-		return otlp.Install(cfgs)
+		install = otlp.Install
+	}
+
+	// Let SelfTest see the result of the exports carrying its probe
+	opts = append(opts[:len(opts):len(opts)], options.WithExporterWrapper(watchProbeExports))
+
+	provider, err := install(cfgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the process initialization duration and cold start
+	recordStartup(provider.Meter(instrumentationScope))
+
+	// Set GOMEMLIMIT from the cgroup memory limit if requested
+	if o := options.New(opts...); o.AutoMemoryLimit {
+		applyMemoryLimit(provider.Meter(instrumentationScope), o.MemoryLimitHeadroom)
+	}
+
+	// Re-bind the collectors registered with Bind to the new provider
+	rebind(provider)
+
+	return provider, nil
+}
+
+// Shutdown flushes and shuts down the MeterProvider installed in the configuration,
+// then removes it from the configuration so a new one can be installed.
+//
+// Parameters:
+//   - ctx: The context bounding the flush and shutdown
+//   - cfgs: Application configuration where the metrics provider is stored
+//
+// Returns:
+//   - An error if the provider fails to shut down
+func Shutdown(ctx context.Context, cfgs *configs.Configs) error {
+	provider, ok := cfgs.MetricsProvider.(*sdkmetric.MeterProvider)
+	if !ok || provider == nil {
+		return nil
 	}
 
-	return noop.Install(cfgs)
+	cfgs.MetricsProvider = nil
+	unbindProvider()
+	return provider.Shutdown(ctx)
 }
@@ -0,0 +1,203 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package metricstest provides utilities for behavioral tests of instrumentation,
+// comparing the collections of a manual reader.
+package metricstest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// FieldValue is the field of the sum and gauge series.
+	FieldValue = "value"
+	// FieldCount is the count field of the histogram series.
+	FieldCount = "count"
+	// FieldSum is the sum field of the histogram series.
+	FieldSum = "sum"
+)
+
+type (
+	// Change describes a series that changed between two collections.
+	Change struct {
+		// Scope is the instrumentation scope name.
+		Scope string
+		// Instrument is the instrument name.
+		Instrument string
+		// Attributes is the canonical encoding of the series attributes, such as "method=GET,status=200".
+		Attributes string
+		// Field is FieldValue for sums and gauges, FieldCount or FieldSum for histograms.
+		Field string
+		// Before is the value in the first collection, zero if the series was added.
+		Before float64
+		// After is the value in the second collection, zero if the series was removed.
+		After float64
+		// Added reports whether the series only exists in the second collection.
+		Added bool
+		// Removed reports whether the series only exists in the first collection.
+		Removed bool
+	}
+
+	// seriesKey identifies a series across collections.
+	seriesKey struct {
+		scope      string
+		instrument string
+		attributes string
+		field      string
+	}
+)
+
+// Collect collects the metrics of the reader.
+//
+// Parameters:
+//   - ctx: The context of the collection
+//   - reader: The manual reader registered in the tested MeterProvider
+//
+// Returns:
+//   - The collected metrics
+//   - An error if the collection fails
+func Collect(ctx context.Context, reader *sdkmetric.ManualReader) (metricdata.ResourceMetrics, error) {
+	var rm metricdata.ResourceMetrics
+	err := reader.Collect(ctx, &rm)
+	return rm, err
+}
+
+// Diff compares two collections and reports the series that were added, removed or
+// whose value changed, ordered by scope, instrument, attributes and field. Exemplars
+// and timestamps are ignored; exponential histograms and summaries are not compared.
+//
+// Parameters:
+//   - before: The first collection
+//   - after: The second collection
+//
+// Returns:
+//   - The changes, empty if the collections hold the same values
+func Diff(before, after metricdata.ResourceMetrics) []Change {
+	b, a := series(before), series(after)
+
+	var changes []Change
+	for key, bv := range b {
+		av, ok := a[key]
+		switch {
+		case !ok:
+			changes = append(changes, key.change(bv, 0, false, true))
+		case av != bv:
+			changes = append(changes, key.change(bv, av, false, false))
+		}
+	}
+	for key, av := range a {
+		if _, ok := b[key]; !ok {
+			changes = append(changes, key.change(0, av, true, false))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		ci, cj := changes[i], changes[j]
+		if ci.Scope != cj.Scope {
+			return ci.Scope < cj.Scope
+		}
+		if ci.Instrument != cj.Instrument {
+			return ci.Instrument < cj.Instrument
+		}
+		if ci.Attributes != cj.Attributes {
+			return ci.Attributes < cj.Attributes
+		}
+		return ci.Field < cj.Field
+	})
+
+	return changes
+}
+
+// Delta returns the difference between the values after and before.
+func (c Change) Delta() float64 {
+	return c.After - c.Before
+}
+
+// String describes the change, for instance in test failure messages.
+func (c Change) String() string {
+	name := c.Instrument
+	if c.Field != FieldValue {
+		name += "." + c.Field
+	}
+	name += "{" + c.Attributes + "}"
+
+	switch {
+	case c.Added:
+		return fmt.Sprintf("+ %s = %g", name, c.After)
+	case c.Removed:
+		return fmt.Sprintf("- %s = %g", name, c.Before)
+	default:
+		return fmt.Sprintf("~ %s %g -> %g (%+g)", name, c.Before, c.After, c.Delta())
+	}
+}
+
+// change creates the Change of the series.
+func (k seriesKey) change(before, after float64, added, removed bool) Change {
+	return Change{
+		Scope:      k.scope,
+		Instrument: k.instrument,
+		Attributes: k.attributes,
+		Field:      k.field,
+		Before:     before,
+		After:      after,
+		Added:      added,
+		Removed:    removed,
+	}
+}
+
+// series flattens the collection into its series values.
+func series(rm metricdata.ResourceMetrics) map[seriesKey]float64 {
+	values := map[seriesKey]float64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			add := func(attrs attribute.Set, field string, v float64) {
+				key := seriesKey{
+					scope:      sm.Scope.Name,
+					instrument: m.Name,
+					attributes: attrs.Encoded(attribute.DefaultEncoder()),
+					field:      field,
+				}
+				values[key] += v
+			}
+
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				addNumberPoints(data.DataPoints, add)
+			case metricdata.Sum[float64]:
+				addNumberPoints(data.DataPoints, add)
+			case metricdata.Gauge[int64]:
+				addNumberPoints(data.DataPoints, add)
+			case metricdata.Gauge[float64]:
+				addNumberPoints(data.DataPoints, add)
+			case metricdata.Histogram[int64]:
+				addHistogramPoints(data.DataPoints, add)
+			case metricdata.Histogram[float64]:
+				addHistogramPoints(data.DataPoints, add)
+			}
+		}
+	}
+	return values
+}
+
+// addNumberPoints adds the values of the data points.
+func addNumberPoints[N int64 | float64](dps []metricdata.DataPoint[N], add func(attribute.Set, string, float64)) {
+	for _, dp := range dps {
+		add(dp.Attributes, FieldValue, float64(dp.Value))
+	}
+}
+
+// addHistogramPoints adds the counts and sums of the histogram data points.
+func addHistogramPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N], add func(attribute.Set, string, float64)) {
+	for _, dp := range dps {
+		add(dp.Attributes, FieldCount, float64(dp.Count))
+		add(dp.Attributes, FieldSum, float64(dp.Sum))
+	}
+}
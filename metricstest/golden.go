@@ -0,0 +1,210 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metricstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// UpdateGoldenEnvKey is the environment variable rewriting the golden files with the
+// current collections instead of comparing them. Default: false
+const UpdateGoldenEnvKey = "METRICSTEST_UPDATE_GOLDEN"
+
+type (
+	// goldenScope is the canonical form of a ScopeMetrics.
+	goldenScope struct {
+		Name    string         `json:"name"`
+		Version string         `json:"version,omitempty"`
+		Metrics []goldenMetric `json:"metrics"`
+	}
+
+	// goldenMetric is the canonical form of a Metrics.
+	goldenMetric struct {
+		Name        string        `json:"name"`
+		Description string        `json:"description,omitempty"`
+		Unit        string        `json:"unit,omitempty"`
+		Type        string        `json:"type"`
+		Temporality string        `json:"temporality,omitempty"`
+		Monotonic   bool          `json:"monotonic,omitempty"`
+		Points      []goldenPoint `json:"points"`
+	}
+
+	// goldenPoint is the canonical form of a data point, without its timestamps.
+	goldenPoint struct {
+		Attributes   map[string]any `json:"attributes,omitempty"`
+		Value        *float64       `json:"value,omitempty"`
+		Count        *uint64        `json:"count,omitempty"`
+		Sum          *float64       `json:"sum,omitempty"`
+		Min          *float64       `json:"min,omitempty"`
+		Max          *float64       `json:"max,omitempty"`
+		Bounds       []float64      `json:"bounds,omitempty"`
+		BucketCounts []uint64       `json:"bucketCounts,omitempty"`
+
+		// key orders the points by attributes.
+		key string
+	}
+)
+
+// MarshalGolden serializes the collection to canonical JSON: the resource, the
+// timestamps and the exemplars are dropped, the scopes, metrics and points are
+// sorted and the output is indented, so instrumentation changes can be reviewed as
+// diffs. Exponential histograms and summaries are not serialized.
+//
+// Parameters:
+//   - rm: The collection to serialize
+//
+// Returns:
+//   - The canonical JSON
+//   - An error if the collection cannot be serialized
+func MarshalGolden(rm metricdata.ResourceMetrics) ([]byte, error) {
+	scopes := make([]goldenScope, 0, len(rm.ScopeMetrics))
+	for _, sm := range rm.ScopeMetrics {
+		scope := goldenScope{Name: sm.Scope.Name, Version: sm.Scope.Version, Metrics: []goldenMetric{}}
+		for _, m := range sm.Metrics {
+			if gm, ok := newGoldenMetric(m); ok {
+				scope.Metrics = append(scope.Metrics, gm)
+			}
+		}
+		sort.Slice(scope.Metrics, func(i, j int) bool { return scope.Metrics[i].Name < scope.Metrics[j].Name })
+		scopes = append(scopes, scope)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].Name < scopes[j].Name })
+
+	b, err := json.MarshalIndent(map[string]any{"scopes": scopes}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// AssertGolden compares the canonical JSON of the collection to the golden file,
+// failing the test on mismatch. With METRICSTEST_UPDATE_GOLDEN=true, the golden file
+// is written instead.
+//
+// Parameters:
+//   - t: The test
+//   - path: The golden file path, such as "testdata/http.golden.json"
+//   - rm: The collection to compare
+func AssertGolden(t testing.TB, path string, rm metricdata.ResourceMetrics) {
+	t.Helper()
+
+	got, err := MarshalGolden(rm)
+	if err != nil {
+		t.Fatalf("failed to marshal metrics: %v", err)
+	}
+
+	if update, _ := strconv.ParseBool(os.Getenv(UpdateGoldenEnvKey)); update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file, run with %s=true to create it: %v", UpdateGoldenEnvKey, err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("metrics don't match golden file %s, run with %s=true to update it\n--- got\n%s\n--- want\n%s", path, UpdateGoldenEnvKey, got, want)
+	}
+}
+
+// newGoldenMetric converts m to its canonical form.
+func newGoldenMetric(m metricdata.Metrics) (goldenMetric, bool) {
+	gm := goldenMetric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+
+	switch data := m.Data.(type) {
+	case metricdata.Sum[int64]:
+		gm.Type, gm.Temporality, gm.Monotonic = "Sum[int64]", data.Temporality.String(), data.IsMonotonic
+		gm.Points = goldenNumberPoints(data.DataPoints)
+	case metricdata.Sum[float64]:
+		gm.Type, gm.Temporality, gm.Monotonic = "Sum[float64]", data.Temporality.String(), data.IsMonotonic
+		gm.Points = goldenNumberPoints(data.DataPoints)
+	case metricdata.Gauge[int64]:
+		gm.Type = "Gauge[int64]"
+		gm.Points = goldenNumberPoints(data.DataPoints)
+	case metricdata.Gauge[float64]:
+		gm.Type = "Gauge[float64]"
+		gm.Points = goldenNumberPoints(data.DataPoints)
+	case metricdata.Histogram[int64]:
+		gm.Type, gm.Temporality = "Histogram[int64]", data.Temporality.String()
+		gm.Points = goldenHistogramPoints(data.DataPoints)
+	case metricdata.Histogram[float64]:
+		gm.Type, gm.Temporality = "Histogram[float64]", data.Temporality.String()
+		gm.Points = goldenHistogramPoints(data.DataPoints)
+	default:
+		return gm, false
+	}
+
+	sort.Slice(gm.Points, func(i, j int) bool { return gm.Points[i].key < gm.Points[j].key })
+	return gm, true
+}
+
+// goldenNumberPoints converts the data points to their canonical form.
+func goldenNumberPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []goldenPoint {
+	points := make([]goldenPoint, 0, len(dps))
+	for _, dp := range dps {
+		v := float64(dp.Value)
+		points = append(points, goldenPoint{
+			Attributes: goldenAttributes(dp.Attributes),
+			Value:      &v,
+			key:        dp.Attributes.Encoded(attribute.DefaultEncoder()),
+		})
+	}
+	return points
+}
+
+// goldenHistogramPoints converts the histogram data points to their canonical form.
+func goldenHistogramPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []goldenPoint {
+	points := make([]goldenPoint, 0, len(dps))
+	for _, dp := range dps {
+		count, sum := dp.Count, float64(dp.Sum)
+		p := goldenPoint{
+			Attributes:   goldenAttributes(dp.Attributes),
+			Count:        &count,
+			Sum:          &sum,
+			Bounds:       dp.Bounds,
+			BucketCounts: dp.BucketCounts,
+			key:          dp.Attributes.Encoded(attribute.DefaultEncoder()),
+		}
+		if v, ok := dp.Min.Value(); ok {
+			f := float64(v)
+			p.Min = &f
+		}
+		if v, ok := dp.Max.Value(); ok {
+			f := float64(v)
+			p.Max = &f
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// goldenAttributes converts the attribute set to a map, serialized with sorted keys.
+func goldenAttributes(set attribute.Set) map[string]any {
+	if set.Len() == 0 {
+		return nil
+	}
+
+	attrs := make(map[string]any, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		attrs[string(kv.Key)] = kv.Value.AsInterface()
+	}
+	return attrs
+}
@@ -4,7 +4,12 @@
 package noop
 
 import (
+	"fmt"
+
 	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/errs"
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
@@ -12,13 +17,39 @@ import (
 // It creates an empty MeterProvider that doesn't perform any actual metrics collection
 // and stores it in the application configuration for later use.
 //
+// When METRICS_NOOP_VALIDATE is true, or with options.WithValidateMode, the provider is
+// installed as the global provider in validate mode: the instruments are created,
+// recorded and collected like with a real exporter, but the collected metrics are only
+// checked for instrumentation bugs, such as conflicting instrument definitions or
+// invalid attributes, reported to the OpenTelemetry error handler wrapping
+// errs.ErrInvalidInstrumentation. Nothing is ever exported, so staging environments
+// catch instrumentation bugs without network traffic. The instruments of a nil or
+// noop meter never reach the provider, CheckMeter detects these meters.
+//
 // Parameters:
 //   - cfgs: Application configuration where the metrics provider will be stored
+//   - opts: Optional settings, only applied in validate mode
 //
 // Returns:
 //   - A configured no-operation MeterProvider that satisfies the interface requirements
-//   - Always returns nil error since this implementation cannot fail
-func Install(cfgs *configs.Configs) (*sdkmetric.MeterProvider, error) {
+//   - An error wrapping errs.ErrInvalidConfig if cfgs is nil, or errs.ErrAlreadyInstalled
+//     if a provider is already installed
+func Install(cfgs *configs.Configs, opts ...options.Option) (*sdkmetric.MeterProvider, error) {
+	if cfgs == nil {
+		return nil, fmt.Errorf("%w: nil configs", errs.ErrInvalidConfig)
+	}
+	if cfgs.MetricsProvider != nil {
+		return nil, errs.ErrAlreadyInstalled
+	}
+
+	if o := options.New(opts...); o.Validate || validateEnabled() {
+		provider := newValidatingProvider(o)
+		validating.Store(true)
+		cfgs.MetricsProvider = provider
+		otel.SetMeterProvider(o.WrapMeterProvider(provider))
+		return provider, nil
+	}
+
 	provider := sdkmetric.NewMeterProvider()
 	cfgs.MetricsProvider = provider
 	return provider, nil
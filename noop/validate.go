@@ -0,0 +1,179 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package noop
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goxkit/metrics/errs"
+	"github.com/goxkit/metrics/options"
+	"github.com/goxkit/metrics/processor"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ValidateEnvKey is the environment variable enabling the validate mode. Default: false
+const ValidateEnvKey = "METRICS_NOOP_VALIDATE"
+
+// ValidationInterval is the interval at which the instruments are collected and
+// validated in validate mode.
+const ValidationInterval = 10 * time.Second
+
+type (
+	// validatingExporter discards the collected metrics after checking them for
+	// instrumentation bugs, reported once each to the OpenTelemetry error handler.
+	validatingExporter struct {
+		mu       sync.Mutex
+		reported map[string]struct{}
+	}
+
+	// streamDefinition identifies the definition of a metric stream.
+	streamDefinition struct {
+		kind        string
+		unit        string
+		description string
+	}
+)
+
+// validating is set once a provider is installed in validate mode.
+var validating atomic.Bool
+
+// validateEnabled reports whether the validate mode is enabled.
+func validateEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(ValidateEnvKey))
+	return enabled
+}
+
+// newValidatingProvider creates a MeterProvider collecting and validating the
+// instruments without ever exporting them. The views, producers and exporter
+// wrappers of the options are applied, so their configuration is exercised too.
+func newValidatingProvider(o *options.Options) *sdkmetric.MeterProvider {
+	exp := o.WrapExporter(&validatingExporter{reported: map[string]struct{}{}})
+
+	readerOpts := []sdkmetric.PeriodicReaderOption{sdkmetric.WithInterval(ValidationInterval)}
+	for _, p := range o.ReaderProducers() {
+		readerOpts = append(readerOpts, sdkmetric.WithProducer(p))
+	}
+
+	providerOpts := []sdkmetric.Option{
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp, readerOpts...)),
+		sdkmetric.WithView(redactViews(o.Views)...),
+	}
+	for _, r := range o.Readers {
+		providerOpts = append(providerOpts, sdkmetric.WithReader(r))
+	}
+
+	return sdkmetric.NewMeterProvider(providerOpts...)
+}
+
+// Temporality returns the default temporality.
+func (e *validatingExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+// Aggregation returns the default aggregation.
+func (e *validatingExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+// Export checks the collected metrics and discards them. It detects the streams
+// defined more than once with conflicting kinds, units or descriptions and the
+// invalid attributes.
+func (e *validatingExporter) Export(_ context.Context, rm *metricdata.ResourceMetrics) error {
+	for _, sm := range rm.ScopeMetrics {
+		definitions := map[string]streamDefinition{}
+		for _, m := range sm.Metrics {
+			def := streamDefinition{kind: fmt.Sprintf("%T", m.Data), unit: m.Unit, description: m.Description}
+			if previous, ok := definitions[m.Name]; ok && previous != def {
+				e.report(fmt.Errorf("%w: conflicting definitions of instrument %q in scope %q: %s %q and %s %q",
+					errs.ErrInvalidInstrumentation, m.Name, sm.Scope.Name, previous.kind, previous.unit, def.kind, def.unit))
+			}
+			definitions[m.Name] = def
+
+			processor.MapAttributes(m.Data, func(set attribute.Set) attribute.Set {
+				for iter := set.Iter(); iter.Next(); {
+					if kv := iter.Attribute(); !kv.Valid() {
+						e.report(fmt.Errorf("%w: invalid attribute %q recorded by instrument %q in scope %q",
+							errs.ErrInvalidInstrumentation, kv.Key, m.Name, sm.Scope.Name))
+					}
+				}
+				return set
+			})
+		}
+	}
+
+	return nil
+}
+
+// report sends err to the OpenTelemetry error handler once.
+func (e *validatingExporter) report(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, ok := e.reported[err.Error()]; ok {
+		return
+	}
+	e.reported[err.Error()] = struct{}{}
+	otel.Handle(err)
+}
+
+// ForceFlush does nothing, nothing is buffered.
+func (e *validatingExporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Shutdown does nothing, nothing is buffered.
+func (e *validatingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// CheckMeter detects a nil meter or a meter of the OpenTelemetry noop package, whose
+// instruments never reach the installed provider, so the validate mode can't check
+// them. Components receiving their meter from the application call it at startup. In
+// validate mode, the error is also reported to the OpenTelemetry error handler.
+//
+// Parameters:
+//   - meter: The meter creating the instruments of the component
+//
+// Returns:
+//   - An error wrapping errs.ErrInvalidInstrumentation for a nil or noop meter
+func CheckMeter(meter metric.Meter) error {
+	var err error
+	switch meter.(type) {
+	case nil:
+		err = fmt.Errorf("%w: nil meter", errs.ErrInvalidInstrumentation)
+	case metricnoop.Meter, *metricnoop.Meter:
+		err = fmt.Errorf("%w: noop meter, its instruments are never collected", errs.ErrInvalidInstrumentation)
+	default:
+		if v := reflect.ValueOf(meter); v.Kind() == reflect.Pointer && v.IsNil() {
+			err = fmt.Errorf("%w: nil %T meter", errs.ErrInvalidInstrumentation, meter)
+		}
+	}
+
+	if err != nil && validating.Load() {
+		otel.Handle(err)
+	}
+	return err
+}
+
+// redactViews returns the views dropping the attributes redacted with
+// METRICS_REDACT_KEYS, so the readers of the options don't expose them.
+func redactViews(views []sdkmetric.View) []sdkmetric.View {
+	if redaction := processor.RedactionFromEnv(); len(redaction.Keys) > 0 {
+		return processor.RedactViews(redaction, views...)
+	}
+	return views
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package noop
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goxkit/metrics/errs"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// TestCheckMeter verifies that the nil and noop meters are detected.
+func TestCheckMeter(t *testing.T) {
+	var nilPointer *metricnoop.Meter
+
+	tests := []struct {
+		name    string
+		meter   metric.Meter
+		invalid bool
+	}{
+		{name: "nil", meter: nil, invalid: true},
+		{name: "nil pointer", meter: nilPointer, invalid: true},
+		{name: "noop", meter: metricnoop.NewMeterProvider().Meter("test"), invalid: true},
+		{name: "sdk", meter: sdkmetric.NewMeterProvider().Meter("test")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckMeter(tt.meter); errors.Is(err, errs.ErrInvalidInstrumentation) != tt.invalid {
+				t.Errorf("got error %v, want invalid %v", err, tt.invalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package options
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// producerFunc adapts a function to the sdkmetric.Producer interface.
+type producerFunc func(context.Context) ([]metricdata.ScopeMetrics, error)
+
+func (f producerFunc) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	return f(ctx)
+}
+
+// TestWithOpenCensusBridgeFactory verifies that the bridge is only created and
+// registered when enabled in the environment.
+func TestWithOpenCensusBridgeFactory(t *testing.T) {
+	tests := []struct {
+		enabled string
+		want    int
+	}{
+		{enabled: "", want: 0},
+		{enabled: "false", want: 0},
+		{enabled: "true", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.enabled, func(t *testing.T) {
+			t.Setenv(OpenCensusBridgeEnvKey, tt.enabled)
+
+			calls := 0
+			o := New(WithOpenCensusBridgeFactory(func() sdkmetric.Producer {
+				calls++
+				return producerFunc(func(context.Context) ([]metricdata.ScopeMetrics, error) { return nil, nil })
+			}))
+
+			if calls != tt.want || len(o.Producers) != tt.want {
+				t.Errorf("got %d factory calls and %d producers, want %d", calls, len(o.Producers), tt.want)
+			}
+		})
+	}
+}
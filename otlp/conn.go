@@ -0,0 +1,131 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlp
+
+import (
+	"context"
+	"sync"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/otel/otlpgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"google.golang.org/grpc"
+)
+
+type (
+	// sharedConn is a gRPC client connection shared by the exporters of several
+	// signals (traces, logs, metrics), closed when its last reference is released.
+	sharedConn struct {
+		conn *grpc.ClientConn
+		refs int
+	}
+
+	// releasingExporter releases the shared connection when the exporter shuts down.
+	releasingExporter struct {
+		sdkmetric.Exporter
+		release func() error
+	}
+)
+
+var (
+	sharedConnsMu sync.Mutex
+	sharedConns   = map[string]*sharedConn{}
+)
+
+// AcquireConn returns the gRPC client connection shared for the OTLP endpoint of the
+// configuration, creating it on first use. Every call must be paired with a call to
+// the returned release function; the connection is closed when the last reference is
+// released, which stops connection leaks when exporters are reinstalled.
+//
+// Connections are shared between configurations with the same endpoint, TLS setting
+// and headers.
+//
+// Parameters:
+//   - cfgs: Application configuration containing OTLP settings
+//
+// Returns:
+//   - The shared gRPC client connection
+//   - A function releasing the reference, safe to call more than once
+//   - An error if the connection cannot be created
+func AcquireConn(cfgs *configs.Configs) (*grpc.ClientConn, func() error, error) {
+	key := connKey(cfgs)
+
+	sharedConnsMu.Lock()
+	defer sharedConnsMu.Unlock()
+
+	shared, ok := sharedConns[key]
+	if !ok {
+		conn, err := otlpgrpc.NewExporterGRPCClient(cfgs)
+		if err != nil {
+			return nil, nil, err
+		}
+		shared = &sharedConn{conn: conn}
+		sharedConns[key] = shared
+	}
+	shared.refs++
+
+	var once sync.Once
+	release := func() error {
+		var err error
+		once.Do(func() {
+			err = releaseConn(key, shared)
+		})
+		return err
+	}
+
+	return shared.conn, release, nil
+}
+
+// SharedConnRefs returns the number of references held on the shared connection
+// of the configuration, or zero if there is none.
+//
+// Parameters:
+//   - cfgs: Application configuration containing OTLP settings
+//
+// Returns:
+//   - The number of references
+func SharedConnRefs(cfgs *configs.Configs) int {
+	sharedConnsMu.Lock()
+	defer sharedConnsMu.Unlock()
+
+	if shared, ok := sharedConns[connKey(cfgs)]; ok {
+		return shared.refs
+	}
+	return 0
+}
+
+// releaseConn drops a reference and closes the connection when it was the last one.
+func releaseConn(key string, shared *sharedConn) error {
+	sharedConnsMu.Lock()
+	defer sharedConnsMu.Unlock()
+
+	shared.refs--
+	if shared.refs > 0 {
+		return nil
+	}
+
+	if sharedConns[key] == shared {
+		delete(sharedConns, key)
+	}
+	return shared.conn.Close()
+}
+
+// connKey identifies the connections that can be shared.
+func connKey(cfgs *configs.Configs) string {
+	tls := "insecure"
+	if cfgs.OTLPConfigs.ExporterTLSEnabled {
+		tls = "tls"
+	}
+	return cfgs.OTLPConfigs.Endpoint + "|" + tls + "|" + cfgs.OTLPConfigs.ExporterHeaders
+}
+
+// Shutdown shuts the exporter down and releases its shared connection reference.
+func (e *releasingExporter) Shutdown(ctx context.Context) error {
+	err := e.Exporter.Shutdown(ctx)
+	if rerr := e.release(); err == nil {
+		err = rerr
+	}
+	return err
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlp
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/goxkit/configs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// SecondaryEndpointEnvKey is the environment variable holding the secondary OTLP
+	// endpoint used when the exports to the primary endpoint keep failing.
+	SecondaryEndpointEnvKey = "OTEL_EXPORTER_OTLP_METRICS_SECONDARY_ENDPOINT"
+
+	// DefaultFailoverThreshold is the default number of consecutive failed exports
+	// to the primary endpoint before switching to the secondary endpoint.
+	DefaultFailoverThreshold = 3
+
+	// FailoverRetryInterval is the interval at which the primary endpoint is tried
+	// again while exporting to the secondary endpoint.
+	FailoverRetryInterval = time.Minute
+)
+
+// failoverExporter exports to the primary exporter, switching to the secondary one
+// after threshold consecutive failures and back when the primary recovers.
+type failoverExporter struct {
+	primary   sdkmetric.Exporter
+	secondary sdkmetric.Exporter
+	threshold int
+	failovers metric.Int64Counter
+
+	mu         sync.Mutex
+	failures   int
+	onSecond   bool
+	retryAfter time.Time
+}
+
+// newFailoverExporter creates an exporter failing over from primary to secondary.
+func newFailoverExporter(primary, secondary sdkmetric.Exporter, threshold int) *failoverExporter {
+	if threshold <= 0 {
+		threshold = DefaultFailoverThreshold
+	}
+
+	// The global meter forwards to the provider once it is installed
+	failovers, err := otel.Meter("github.com/goxkit/metrics/otlp").Int64Counter("exporter.failovers", metric.WithDescription("Number of switches between the primary and secondary OTLP endpoints."))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return &failoverExporter{
+		primary:   primary,
+		secondary: secondary,
+		threshold: threshold,
+		failovers: failovers,
+	}
+}
+
+// newSecondaryExporter creates the exporter of the secondary endpoint, sharing the
+// connection settings of the configuration.
+func newSecondaryExporter(ctx context.Context, cfgs *configs.Configs, endpoint string, proxy *url.URL) (sdkmetric.Exporter, error) {
+	otlpCfgs := *cfgs.OTLPConfigs
+	otlpCfgs.Endpoint = endpoint
+	secondaryCfgs := *cfgs
+	secondaryCfgs.OTLPConfigs = &otlpCfgs
+
+	if proxy != nil {
+		return otlpmetricgrpc.New(ctx, proxyExporterOptions(&secondaryCfgs, proxy)...)
+	}
+
+	conn, release, err := AcquireConn(&secondaryCfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithGRPCConn(conn))
+	if err != nil {
+		_ = release()
+		return nil, err
+	}
+
+	return &releasingExporter{Exporter: exp, release: release}, nil
+}
+
+// secondaryEndpoint returns the secondary endpoint set by options.WithFailover or
+// SecondaryEndpointEnvKey, empty if failover is disabled.
+func secondaryEndpoint(endpoint string) string {
+	if endpoint != "" {
+		return endpoint
+	}
+	return os.Getenv(SecondaryEndpointEnvKey)
+}
+
+// Temporality returns the temporality of the primary exporter.
+func (e *failoverExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return e.primary.Temporality(k)
+}
+
+// Aggregation returns the aggregation of the primary exporter.
+func (e *failoverExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return e.primary.Aggregation(k)
+}
+
+// Export exports to the active endpoint. While on the secondary endpoint, the primary
+// endpoint is tried again every FailoverRetryInterval. When a failed export to the
+// primary endpoint falls over to the secondary one, the primary attempt gets half of
+// the remaining deadline, so a hanging primary doesn't leave the secondary attempt
+// an expired context.
+func (e *failoverExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	tryPrimary := !e.onSecond || time.Now().After(e.retryAfter)
+	mayFailOver := e.onSecond || e.failures+1 >= e.threshold
+	e.mu.Unlock()
+
+	if tryPrimary {
+		primaryCtx, cancel := attemptContext(ctx, mayFailOver)
+		err := e.primary.Export(primaryCtx, rm)
+		cancel()
+		if e.primaryResult(ctx, err) {
+			return err
+		}
+	}
+
+	return e.secondary.Export(ctx, rm)
+}
+
+// attemptContext returns the context of the export to the primary endpoint, limited
+// to half of the remaining deadline of ctx when share is true.
+func attemptContext(ctx context.Context, share bool) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !share || !ok {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, time.Now().Add(time.Until(deadline)/2))
+}
+
+// primaryResult records the result of an export to the primary endpoint, switching
+// endpoints when needed, and reports whether the export is complete.
+func (e *failoverExporter) primaryResult(ctx context.Context, err error) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err == nil {
+		e.failures = 0
+		if e.onSecond {
+			e.onSecond = false
+			e.recordFailover(ctx, "primary")
+		}
+		return true
+	}
+
+	if e.onSecond {
+		e.retryAfter = time.Now().Add(FailoverRetryInterval)
+		return false
+	}
+
+	e.failures++
+	if e.failures < e.threshold {
+		return true
+	}
+
+	e.onSecond = true
+	e.retryAfter = time.Now().Add(FailoverRetryInterval)
+	e.recordFailover(ctx, "secondary")
+	return false
+}
+
+// recordFailover increments the failover counter with the endpoint switched to.
+func (e *failoverExporter) recordFailover(ctx context.Context, to string) {
+	if e.failovers != nil {
+		e.failovers.Add(ctx, 1, metric.WithAttributes(attribute.String("endpoint", to)))
+	}
+}
+
+// ForceFlush flushes both exporters.
+func (e *failoverExporter) ForceFlush(ctx context.Context) error {
+	return errors.Join(e.primary.ForceFlush(ctx), e.secondary.ForceFlush(ctx))
+}
+
+// Shutdown shuts both exporters down.
+func (e *failoverExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.primary.Shutdown(ctx), e.secondary.Shutdown(ctx))
+}
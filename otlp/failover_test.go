@@ -0,0 +1,137 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+// stubExporter returns err from Export, after the context is done when hang is set.
+type stubExporter struct {
+	err   error
+	hang  bool
+	calls int
+	// ctxErr is the error of the context once the last export returned.
+	ctxErr error
+}
+
+func (e *stubExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (e *stubExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *stubExporter) Export(ctx context.Context, _ *metricdata.ResourceMetrics) error {
+	e.calls++
+	if e.hang {
+		<-ctx.Done()
+		e.ctxErr = ctx.Err()
+		return ctx.Err()
+	}
+	e.ctxErr = ctx.Err()
+	return e.err
+}
+
+func (e *stubExporter) ForceFlush(context.Context) error { return nil }
+
+func (e *stubExporter) Shutdown(context.Context) error { return nil }
+
+// export exports once and returns the number of calls of each exporter since the previous export.
+func export(t *testing.T, e *failoverExporter, primary, secondary *stubExporter) (int, int, error) {
+	t.Helper()
+
+	p, s := primary.calls, secondary.calls
+	err := e.Export(context.Background(), &metricdata.ResourceMetrics{})
+	return primary.calls - p, secondary.calls - s, err
+}
+
+// TestFailoverThreshold verifies that the exports switch to the secondary endpoint
+// after threshold consecutive failures, and stay there until the retry interval.
+func TestFailoverThreshold(t *testing.T) {
+	primary, secondary := &stubExporter{err: errUnavailable}, &stubExporter{}
+	e := newFailoverExporter(primary, secondary, 3)
+
+	for i := range 2 {
+		if p, s, err := export(t, e, primary, secondary); p != 1 || s != 0 || !errors.Is(err, errUnavailable) {
+			t.Fatalf("export %d: got %d primary and %d secondary calls, error %v, want the primary failure", i, p, s, err)
+		}
+	}
+
+	if p, s, err := export(t, e, primary, secondary); p != 1 || s != 1 || err != nil {
+		t.Fatalf("got %d primary and %d secondary calls, error %v, want the switch to the secondary", p, s, err)
+	}
+	if p, s, err := export(t, e, primary, secondary); p != 0 || s != 1 || err != nil {
+		t.Fatalf("got %d primary and %d secondary calls, error %v, want the secondary only", p, s, err)
+	}
+}
+
+// TestFailoverRetryInterval verifies that the primary endpoint is tried again once
+// the retry interval elapsed, and that a new failure waits for a new interval.
+func TestFailoverRetryInterval(t *testing.T) {
+	primary, secondary := &stubExporter{err: errUnavailable}, &stubExporter{}
+	e := newFailoverExporter(primary, secondary, 1)
+
+	if p, s, _ := export(t, e, primary, secondary); p != 1 || s != 1 {
+		t.Fatalf("got %d primary and %d secondary calls, want the switch to the secondary", p, s)
+	}
+	if remaining := time.Until(e.retryAfter); remaining <= FailoverRetryInterval-time.Second || remaining > FailoverRetryInterval {
+		t.Fatalf("got the primary retried in %v, want %v", remaining, FailoverRetryInterval)
+	}
+
+	e.retryAfter = time.Now().Add(-time.Millisecond)
+	if p, s, err := export(t, e, primary, secondary); p != 1 || s != 1 || err != nil {
+		t.Fatalf("got %d primary and %d secondary calls, error %v, want the primary retried then the secondary", p, s, err)
+	}
+	if p, s, _ := export(t, e, primary, secondary); p != 0 || s != 1 {
+		t.Fatalf("got %d primary and %d secondary calls, want the secondary until the next interval", p, s)
+	}
+}
+
+// TestFailoverSwitchBack verifies that the exports switch back to the primary
+// endpoint once it recovers.
+func TestFailoverSwitchBack(t *testing.T) {
+	primary, secondary := &stubExporter{err: errUnavailable}, &stubExporter{}
+	e := newFailoverExporter(primary, secondary, 1)
+
+	if _, s, _ := export(t, e, primary, secondary); s != 1 {
+		t.Fatal("the failed primary export didn't switch to the secondary")
+	}
+
+	primary.err = nil
+	e.retryAfter = time.Now().Add(-time.Millisecond)
+	if p, s, err := export(t, e, primary, secondary); p != 1 || s != 0 || err != nil {
+		t.Fatalf("got %d primary and %d secondary calls, error %v, want the primary only", p, s, err)
+	}
+	if p, s, _ := export(t, e, primary, secondary); p != 1 || s != 0 || e.onSecond {
+		t.Fatalf("got %d primary and %d secondary calls, want the primary restored", p, s)
+	}
+}
+
+// TestFailoverDeadline verifies that a hanging primary export leaves the secondary
+// export a share of the deadline.
+func TestFailoverDeadline(t *testing.T) {
+	primary, secondary := &stubExporter{hang: true}, &stubExporter{}
+	e := newFailoverExporter(primary, secondary, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	if err := e.Export(ctx, &metricdata.ResourceMetrics{}); err != nil {
+		t.Fatalf("got error %v, want the secondary export to succeed", err)
+	}
+	if !errors.Is(primary.ctxErr, context.DeadlineExceeded) || secondary.calls != 1 || secondary.ctxErr != nil {
+		t.Fatalf("got primary context error %v and secondary context error %v, want only the primary attempt expired", primary.ctxErr, secondary.ctxErr)
+	}
+}
@@ -5,67 +5,175 @@ package otlp
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/goxkit/configs"
-	"github.com/goxkit/otel/otlpgrpc"
+	"github.com/goxkit/metrics/errs"
+	"github.com/goxkit/metrics/options"
+	"github.com/goxkit/metrics/processor"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
-	"go.opentelemetry.io/otel/sdk/resource"
-	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
 	"go.uber.org/zap"
 )
 
 // Install creates and configures an OpenTelemetry Protocol (OTLP) metrics provider.
 // It sets up a gRPC connection to the configured OTLP endpoint, creates an exporter,
-// and initializes a MeterProvider with appropriate resource attributes.
+// and initializes a MeterProvider with appropriate resource attributes. The attribute
+// limits and the redaction configured in the environment, see
+// processor.AttributeLimitsFromEnv and processor.RedactionFromEnv, are enforced on the
+// exported metrics before the registered exporter wrappers run. The redacted
+// attributes are also dropped from the data points of every reader.
 //
 // Parameters:
 //   - cfgs: Application configuration containing OTLP settings and where the metrics provider will be stored
+//   - opts: Optional settings to customize the MeterProvider
 //
 // Returns:
 //   - A configured MeterProvider that exports metrics via OTLP
-//   - An error if any part of the configuration process fails
-func Install(cfgs *configs.Configs) (*sdkmetric.MeterProvider, error) {
+//   - An error if any part of the configuration process fails, wrapping errs.ErrInvalidConfig,
+//     errs.ErrAlreadyInstalled or errs.ErrExporterUnavailable
+func Install(cfgs *configs.Configs, opts ...options.Option) (*sdkmetric.MeterProvider, error) {
 	ctx := context.Background()
+	o := options.New(opts...)
 
-	// Create a gRPC client connection if one doesn't exist yet
-	if cfgs.OTLPExporterConn == nil {
-		conn, err := otlpgrpc.NewExporterGRPCClient(cfgs)
+	if err := validate(cfgs); err != nil {
+		return nil, err
+	}
+
+	proxy, err := proxyURL(o)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only allow-listed endpoints are used in strict mode
+	if err := checkStrict(cfgs, o, proxy); err != nil {
+		return nil, err
+	}
+
+	// Acquire the shared gRPC client connection if one wasn't provided yet,
+	// it is released when the exporter shuts down. With an explicit proxy, the
+	// exporter owns a connection tunneled through the proxy instead.
+	release := func() error { return nil }
+	exporterOpts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithGRPCConn(cfgs.OTLPExporterConn)}
+	switch {
+	case cfgs.OTLPExporterConn == nil && proxy != nil:
+		exporterOpts = proxyExporterOptions(cfgs, proxy)
+	case cfgs.OTLPExporterConn == nil:
+		conn, rel, err := AcquireConn(cfgs)
 		if err != nil {
 			cfgs.Logger.Error("failed to create grpc exporter", zap.Error(err))
-			return nil, err
+			return nil, fmt.Errorf("%w: %w", errs.ErrExporterUnavailable, err)
 		}
 		cfgs.OTLPExporterConn = conn
+		exporterOpts = []otlpmetricgrpc.Option{otlpmetricgrpc.WithGRPCConn(conn)}
+		release = func() error {
+			// Don't leave a closed connection behind for the next install
+			if cfgs.OTLPExporterConn == conn {
+				cfgs.OTLPExporterConn = nil
+			}
+			return rel()
+		}
 	}
 
 	// Create the OTLP metrics exporter using the gRPC connection
-	exp, err := otlpmetricgrpc.New(
-		ctx,
-		otlpmetricgrpc.WithGRPCConn(cfgs.OTLPExporterConn),
-	)
+	otlpExp, err := otlpmetricgrpc.New(ctx, exporterOpts...)
 	if err != nil {
 		cfgs.Logger.Error("failed to create OTLP metric exporter", zap.Error(err))
+		_ = release()
+		return nil, fmt.Errorf("%w: %w", errs.ErrExporterUnavailable, err)
+	}
+
+	var otlpExporter sdkmetric.Exporter = &releasingExporter{Exporter: otlpExp, release: release}
+
+	// Fail over to the secondary endpoint, if any, when the primary keeps failing
+	if endpoint := secondaryEndpoint(o.SecondaryEndpoint); endpoint != "" {
+		secondary, err := newSecondaryExporter(ctx, cfgs, endpoint, proxy)
+		if err != nil {
+			cfgs.Logger.Error("failed to create secondary OTLP metric exporter", zap.Error(err))
+			_ = otlpExporter.Shutdown(ctx)
+			return nil, fmt.Errorf("%w: %w", errs.ErrExporterUnavailable, err)
+		}
+		otlpExporter = newFailoverExporter(otlpExporter, secondary, o.FailoverThreshold)
+	}
+
+	// Apply the exporter wrappers, such as processing hooks, then the processing
+	// configured in the environment, which runs first
+	exp := envProcessing(o, o.WrapExporter(otlpExporter))
+
+	// Create the resource from the configuration and the resource detectors
+	res, err := newResource(ctx, cfgs, o)
+	if err != nil {
+		cfgs.Logger.Error("failed to create metrics resource", zap.Error(err))
+		_ = exp.Shutdown(ctx)
 		return nil, err
 	}
 
-	// Create the meter provider with periodic collection and resource attributes
-	meterProvider := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp)),
-		sdkmetric.WithResource(resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceNameKey.String(cfgs.AppConfigs.Name),
-			semconv.ServiceNamespaceKey.String(cfgs.AppConfigs.Namespace),
-			attribute.String("service.environment", cfgs.AppConfigs.Environment.String()),
-			semconv.DeploymentEnvironmentNameKey.String(cfgs.AppConfigs.Environment.String()),
-			semconv.TelemetrySDKLanguageKey.String("go"),
-		)),
-	)
-
-	// Store the provider in the configs and set as global provider
+	// Create the meter provider with periodic collection and resource attributes,
+	// the external producers, such as the OpenCensus bridge, are registered in the reader
+	providerOpts := []sdkmetric.Option{
+		sdkmetric.WithReader(o.NewReader(exp)),
+		sdkmetric.WithResource(res),
+		sdkmetric.WithView(envViews(o.Views)...),
+	}
+	for _, r := range o.Readers {
+		providerOpts = append(providerOpts, sdkmetric.WithReader(r))
+	}
+	meterProvider := sdkmetric.NewMeterProvider(providerOpts...)
+
+	// Store the provider in the configs and set it, wrapped, as global provider
 	cfgs.MetricsProvider = meterProvider
-	otel.SetMeterProvider(meterProvider)
+	otel.SetMeterProvider(o.WrapMeterProvider(meterProvider))
 
 	return meterProvider, nil
 }
+
+// envProcessing wraps exp with the hooks configured in the environment, the redaction
+// of METRICS_REDACT_KEYS and the attribute limits of OTEL_ATTRIBUTE_COUNT_LIMIT and
+// OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT, and with the cardinality limit of the options.
+func envProcessing(o *options.Options, exp sdkmetric.Exporter) sdkmetric.Exporter {
+	var hooks []processor.Hook
+	if redaction := processor.RedactionFromEnv(); len(redaction.Keys) > 0 {
+		hooks = append(hooks, processor.Redact(redaction))
+	}
+	if limits := processor.AttributeLimitsFromEnv(); limits.CountLimit > 0 || limits.ValueLengthLimit > 0 {
+		hooks = append(hooks, processor.LimitAttributes(limits))
+	}
+	if o.CardinalityLimit > 0 {
+		hooks = append(hooks, processor.LimitCardinality(o.CardinalityLimit))
+	}
+
+	if len(hooks) == 0 {
+		return exp
+	}
+	return processor.NewExporter(exp, hooks...)
+}
+
+// envViews returns the views of the MeterProvider dropping the attributes redacted
+// with METRICS_REDACT_KEYS, so no reader, such as the Prometheus handler, exposes them.
+func envViews(views []sdkmetric.View) []sdkmetric.View {
+	if redaction := processor.RedactionFromEnv(); len(redaction.Keys) > 0 {
+		return processor.RedactViews(redaction, views...)
+	}
+	return views
+}
+
+// validate checks the configuration settings required by the OTLP implementation.
+func validate(cfgs *configs.Configs) error {
+	switch {
+	case cfgs == nil:
+		return fmt.Errorf("%w: nil configs", errs.ErrInvalidConfig)
+	case cfgs.Logger == nil:
+		return fmt.Errorf("%w: nil logger", errs.ErrInvalidConfig)
+	case cfgs.AppConfigs == nil:
+		return fmt.Errorf("%w: nil app configs", errs.ErrInvalidConfig)
+	case cfgs.OTLPConfigs == nil:
+		return fmt.Errorf("%w: nil OTLP configs", errs.ErrInvalidConfig)
+	case cfgs.OTLPExporterConn == nil && cfgs.OTLPConfigs.Endpoint == "":
+		return fmt.Errorf("%w: empty OTLP endpoint", errs.ErrInvalidConfig)
+	case cfgs.MetricsProvider != nil:
+		return errs.ErrAlreadyInstalled
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlp
+
+import (
+	"context"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"go.uber.org/zap"
+)
+
+// newResource creates the resource describing the application. The attributes
+// found by the detectors are merged first, so the attributes derived from the
+// application configuration always take precedence. In strict mode, only the local
+// detectors run.
+//
+// A failing detector only loses its own attributes: detector errors, such as
+// resource.ErrPartialResource or resource.ErrSchemaURLConflict, are logged as
+// warnings and the detected resource is kept. It fails only if no resource is returned.
+func newResource(ctx context.Context, cfgs *configs.Configs, o *options.Options) (*resource.Resource, error) {
+	res, err := resource.New(
+		ctx,
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithDetectors(resourceDetectors(o)...),
+		resource.WithAttributes(
+			semconv.ServiceNameKey.String(cfgs.AppConfigs.Name),
+			semconv.ServiceNamespaceKey.String(cfgs.AppConfigs.Namespace),
+			attribute.String("service.environment", cfgs.AppConfigs.Environment.String()),
+			semconv.DeploymentEnvironmentNameKey.String(cfgs.AppConfigs.Environment.String()),
+			semconv.TelemetrySDKLanguageKey.String("go"),
+		),
+	)
+	if err != nil && res != nil {
+		cfgs.Logger.Warn("incomplete metrics resource", zap.Error(err))
+		return res, nil
+	}
+
+	return res, err
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// detectorFunc adapts a function to the resource.Detector interface.
+type detectorFunc func(ctx context.Context) (*resource.Resource, error)
+
+func (f detectorFunc) Detect(ctx context.Context) (*resource.Resource, error) {
+	return f(ctx)
+}
+
+// TestNewResourceDetectorErrors verifies that the failing detectors are logged as
+// warnings and don't fail the resource creation.
+func TestNewResourceDetectorErrors(t *testing.T) {
+	tests := map[string]resource.Detector{
+		"partial resource": detectorFunc(func(context.Context) (*resource.Resource, error) {
+			return resource.NewSchemaless(attribute.String("host.name", "h1")), fmt.Errorf("%w: missing value", resource.ErrPartialResource)
+		}),
+		"schema URL conflict": detectorFunc(func(context.Context) (*resource.Resource, error) {
+			return resource.NewWithAttributes("https://opentelemetry.io/schemas/0.0.1", attribute.String("host.name", "h1")), nil
+		}),
+		"failing detector": detectorFunc(func(context.Context) (*resource.Resource, error) {
+			return nil, fmt.Errorf("metadata endpoint unreachable")
+		}),
+	}
+
+	for name, detector := range tests {
+		t.Run(name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.WarnLevel)
+			cfgs := &configs.Configs{
+				Logger:     zap.New(core),
+				AppConfigs: &configs.AppConfigs{Name: "app"},
+			}
+
+			res, err := newResource(context.Background(), cfgs, options.New(options.WithResourceDetectors(detector)))
+			if err != nil {
+				t.Fatalf("got error %v, want the detector error logged", err)
+			}
+			if v, ok := res.Set().Value("service.name"); !ok || v.AsString() != "app" {
+				t.Errorf("got service.name %q, want app", v.AsString())
+			}
+			if logs.FilterMessage("incomplete metrics resource").Len() != 1 {
+				t.Errorf("got logs %v, want the incomplete resource warning", logs.All())
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package otlp
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/detectors"
+	"github.com/goxkit/metrics/errs"
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// checkStrict verifies, in strict mode, that the primary and secondary endpoints and
+// the proxy are allow-listed.
+func checkStrict(cfgs *configs.Configs, o *options.Options, proxy *url.URL) error {
+	if !o.StrictMode {
+		return nil
+	}
+
+	// An external connection without endpoint can't be verified
+	if cfgs.OTLPConfigs.Endpoint == "" {
+		return fmt.Errorf("%w: strict mode requires the OTLP endpoint", errs.ErrInvalidConfig)
+	}
+
+	endpoints := []string{cfgs.OTLPConfigs.Endpoint}
+	if endpoint := secondaryEndpoint(o.SecondaryEndpoint); endpoint != "" {
+		endpoints = append(endpoints, endpoint)
+	}
+	if proxy != nil {
+		endpoints = append(endpoints, proxy.Host)
+	}
+
+	for _, endpoint := range endpoints {
+		if !allowedEndpoint(o.AllowedEndpoints, endpoint) {
+			return fmt.Errorf("%w: strict mode: endpoint %q is not allow-listed", errs.ErrInvalidConfig, endpoint)
+		}
+	}
+
+	return nil
+}
+
+// allowedEndpoint reports whether endpoint matches an allowed host:port, or an allowed
+// host without port.
+func allowedEndpoint(allowed []string, endpoint string) bool {
+	endpoint = normalizeEndpoint(endpoint)
+	host := endpoint
+	if h, _, err := net.SplitHostPort(endpoint); err == nil {
+		host = h
+	}
+
+	for _, a := range allowed {
+		a = normalizeEndpoint(a)
+		if a == endpoint || a == host {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeEndpoint removes the scheme and path of an endpoint, keeping host[:port].
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.ToLower(strings.TrimSpace(endpoint))
+	if i := strings.Index(endpoint, "://"); i >= 0 {
+		endpoint = endpoint[i+3:]
+	}
+	if i := strings.IndexByte(endpoint, '/'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	return endpoint
+}
+
+// resourceDetectors returns the resource detectors to run, only the local ones in strict mode.
+func resourceDetectors(o *options.Options) []resource.Detector {
+	detected := append([]resource.Detector{detectors.Env(), detectors.ServiceInstanceIDFromEnv()}, o.ResourceDetectors...)
+	if !o.StrictMode {
+		return detected
+	}
+
+	local := detected[:0]
+	for _, d := range detected {
+		if detectors.Local(d) {
+			local = append(local, d)
+		}
+	}
+	return local
+}
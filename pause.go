@@ -0,0 +1,89 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"path"
+	"sync/atomic"
+
+	"github.com/goxkit/metrics/options"
+	"github.com/goxkit/metrics/processor"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// exportPaused reports whether the noisy metrics are held back from export.
+var exportPaused atomic.Bool
+
+// Pause stops exporting the noisy metrics selected with PausableExport, for
+// instance during the shutdown drain of a deploy, so the draining instance
+// doesn't skew the dashboards. The other metrics, such as the final counters,
+// are still exported and flushed on Shutdown.
+func Pause() {
+	exportPaused.Store(true)
+}
+
+// Resume exports the metrics held back by Pause again.
+func Resume() {
+	exportPaused.Store(false)
+}
+
+// Paused reports whether the export of the noisy metrics is paused.
+//
+// Returns:
+//   - true between Pause and Resume
+func Paused() bool {
+	return exportPaused.Load()
+}
+
+// PausableExport makes the export pausable with Pause and Resume. While paused,
+// the metrics whose name matches any of the patterns are dropped before export.
+// Without patterns, every metric but the monotonic counters is dropped, keeping
+// the final counter values while silencing the per-request histograms and gauges.
+// Patterns use the path.Match syntax, for example "http.*".
+//
+// Parameters:
+//   - patterns: The name patterns of the noisy metrics
+//
+// Returns:
+//   - An Option registering the pausing exporter wrapper
+func PausableExport(patterns ...string) options.Option {
+	filter := processor.FilterMetrics(func(m metricdata.Metrics) bool {
+		return !pausedMetric(m, patterns)
+	})
+
+	return options.WithExporterWrapper(processor.Wrap(processor.HookFunc(func(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+		if !Paused() {
+			return nil
+		}
+		return filter.Process(ctx, rm)
+	})))
+}
+
+// pausedMetric reports whether m is held back while the export is paused.
+func pausedMetric(m metricdata.Metrics, patterns []string) bool {
+	if len(patterns) == 0 {
+		return !isMonotonicSum(m.Data)
+	}
+
+	for _, p := range patterns {
+		if ok, err := path.Match(p, m.Name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isMonotonicSum reports whether data is a counter aggregation.
+func isMonotonicSum(data metricdata.Aggregation) bool {
+	switch d := data.(type) {
+	case metricdata.Sum[int64]:
+		return d.IsMonotonic
+	case metricdata.Sum[float64]:
+		return d.IsMonotonic
+	default:
+		return false
+	}
+}
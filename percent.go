@@ -0,0 +1,101 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"math"
+
+	"github.com/goxkit/metrics/sanitize"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// PercentUnit is the UCUM unit of the percentage gauges.
+const PercentUnit = "%"
+
+// ClampPercent clamps a percentage to the [0, 100] range.
+//
+// Parameters:
+//   - value: The percentage
+//
+// Returns:
+//   - The clamped percentage, 0 for NaN
+func ClampPercent(value float64) float64 {
+	switch {
+	case math.IsNaN(value) || value < 0:
+		return 0
+	case value > 100:
+		return 100
+	default:
+		return value
+	}
+}
+
+// Percent records a 0–100 percentage, such as a utilization or a completion, in the
+// gauge with the given name. The value is clamped to [0, 100] and the gauge is created
+// with the % unit. Instrument creation errors are reported to the OpenTelemetry error
+// handler.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - name: The gauge name
+//   - value: The percentage
+//   - attrs: The attributes of the measurement
+func (r *Recorder) Percent(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) {
+	gauge, err := cachedInstrument(r, "percent:"+name, func() (metric.Float64Gauge, error) {
+		return r.meter.Float64Gauge(name, metric.WithUnit(PercentUnit))
+	})
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	gauge.Record(ctx, ClampPercent(value), metric.WithAttributes(r.attributes(ctx, attrs)...))
+}
+
+// PercentRatio registers an observable percentage gauge derived from two values read on
+// every collection, such as busy and total workers, or processed and total items. The
+// gauge reports 100 * numerator / denominator clamped to [0, 100], and is not observed
+// while the denominator is not positive.
+//
+// Parameters:
+//   - name: The gauge name
+//   - numerator: The function returning the numerator, it must be safe for concurrent use
+//   - denominator: The function returning the denominator, it must be safe for concurrent use
+//   - attrs: The attributes attached to every observation
+//
+// Returns:
+//   - A registration that can be used to stop observing the gauge
+//   - An error if the gauge or its callback cannot be registered
+func (r *Recorder) PercentRatio(name string, numerator, denominator func() float64, attrs ...attribute.KeyValue) (metric.Registration, error) {
+	set := attribute.NewSet(sanitize.Attributes(attrs)...)
+
+	gauge, err := r.meter.Float64ObservableGauge(name, metric.WithUnit(PercentUnit))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		total := denominator()
+		if total <= 0 {
+			return nil
+		}
+
+		observer.ObserveFloat64(gauge, ClampPercent(100*numerator()/total), metric.WithAttributeSet(set))
+		return nil
+	}, gauge)
+}
+
+// Percent records a clamped 0–100 percentage in the named gauge using the default Recorder.
+func Percent(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) {
+	defaultRecorder.Percent(ctx, name, value, attrs...)
+}
+
+// PercentRatio registers a percentage gauge derived from two values using the default Recorder.
+func PercentRatio(name string, numerator, denominator func() float64, attrs ...attribute.KeyValue) (metric.Registration, error) {
+	return defaultRecorder.PercentRatio(name, numerator, denominator, attrs...)
+}
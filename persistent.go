@@ -0,0 +1,294 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/internal/attrcodec"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultCheckpointInterval is the default interval between two counter checkpoints.
+const DefaultCheckpointInterval = 10 * time.Second
+
+type (
+	// PersistentCounterConfig configures a PersistentCounter.
+	PersistentCounterConfig struct {
+		// Path is the checkpoint file, its directory must exist.
+		Path string
+		// Interval is the time between two checkpoints. Default: DefaultCheckpointInterval
+		Interval time.Duration
+		// Offset initializes the counter without attributes when no checkpoint exists,
+		// for instance with the last value known from the backend.
+		Offset float64
+		// Description is the description of the counter.
+		Description string
+		// Unit is the unit of the counter.
+		Unit string
+	}
+
+	// PersistentCounter is a counter whose values survive restarts: they are
+	// checkpointed to disk periodically and on Close, then restored on creation, so
+	// business-critical counters, such as revenue events, never reset after a deploy
+	// and keep dashboards monotonic. The values recorded since the last checkpoint
+	// are lost if the process crashes.
+	//
+	// A PersistentCounter is safe for concurrent use.
+	PersistentCounter struct {
+		name string
+		cfg  PersistentCounterConfig
+
+		mu     sync.Mutex
+		reg    metric.Registration
+		series map[attribute.Distinct]*persistentSeries
+
+		stop      chan struct{}
+		closeOnce sync.Once
+		wg        sync.WaitGroup
+	}
+
+	// persistentSeries is the value of an attribute set.
+	persistentSeries struct {
+		set   attribute.Set
+		value float64
+	}
+
+	// checkpointSeries is the serialized form of a series.
+	checkpointSeries struct {
+		Attributes []attrcodec.KeyValue `json:"attributes"`
+		Value      float64              `json:"value"`
+	}
+)
+
+// NewPersistentCounter creates a PersistentCounter exported with the given meter,
+// restores its values from the checkpoint file and starts the checkpoint goroutine.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the instrument
+//   - name: The counter name
+//   - cfg: The checkpoint settings
+//
+// Returns:
+//   - A started PersistentCounter, Close must be called on shutdown
+//   - An error if the checkpoint cannot be read or the instrument cannot be registered
+func NewPersistentCounter(meter metric.Meter, name string, cfg PersistentCounterConfig) (*PersistentCounter, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("persistent counter: empty checkpoint path")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultCheckpointInterval
+	}
+
+	c := &PersistentCounter{
+		name:   name,
+		cfg:    cfg,
+		series: map[attribute.Distinct]*persistentSeries{},
+		stop:   make(chan struct{}),
+	}
+
+	if err := c.restore(); err != nil {
+		return nil, err
+	}
+
+	if err := c.Rebind(meter); err != nil {
+		return nil, err
+	}
+
+	c.wg.Add(1)
+	go c.run()
+
+	return c, nil
+}
+
+// Add increments the counter by value.
+//
+// Parameters:
+//   - value: The non-negative increment, negative values are ignored
+//   - attrs: The attributes of the measurement
+func (c *PersistentCounter) Add(value float64, attrs ...attribute.KeyValue) {
+	if value < 0 {
+		return
+	}
+
+	set := attribute.NewSet(attrs...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.series[set.Equivalent()]
+	if !ok {
+		s = &persistentSeries{set: set}
+		c.series[set.Equivalent()] = s
+	}
+	s.value += value
+}
+
+// Checkpoint writes the current values to the checkpoint file atomically.
+//
+// Returns:
+//   - An error if the file cannot be written
+func (c *PersistentCounter) Checkpoint() error {
+	c.mu.Lock()
+	series := make([]checkpointSeries, 0, len(c.series))
+	for _, s := range c.series {
+		series = append(series, checkpointSeries{Attributes: attrcodec.Encode(s.set), Value: s.value})
+	}
+	c.mu.Unlock()
+
+	b, err := json.Marshal(series)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file renamed over the checkpoint, so a crash never leaves
+	// a truncated checkpoint behind
+	tmp, err := os.CreateTemp(filepath.Dir(c.cfg.Path), filepath.Base(c.cfg.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.cfg.Path)
+}
+
+// Close stops the checkpoint goroutine, writes a final checkpoint and stops
+// reporting the counter.
+//
+// Returns:
+//   - An error if the final checkpoint cannot be written
+func (c *PersistentCounter) Close() error {
+	err := errors.New("persistent counter: already closed")
+	c.closeOnce.Do(func() {
+		close(c.stop)
+		c.wg.Wait()
+
+		c.mu.Lock()
+		reg := c.reg
+		c.mu.Unlock()
+
+		err = errors.Join(c.Checkpoint(), reg.Unregister())
+	})
+
+	return err
+}
+
+// Rebind exports the counter with the given meter, for instance of a rebuilt
+// MeterProvider, unregistering it from the previous meter. The values are kept, so
+// the counter stays monotonic across the rebuild. It is usually called by a Bind
+// collector:
+//
+//	metrics.Bind("orders.revenue", func(provider metric.MeterProvider) error {
+//		return revenue.Rebind(provider.Meter("orders"))
+//	})
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the instrument
+//
+// Returns:
+//   - An error if the instrument cannot be registered, the counter is then still
+//     exported with the previous meter
+func (c *PersistentCounter) Rebind(meter metric.Meter) error {
+	counter, err := meter.Float64ObservableCounter(c.name, metric.WithDescription(c.cfg.Description), metric.WithUnit(c.cfg.Unit))
+	if err != nil {
+		return err
+	}
+
+	reg, err := meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		return c.observe(observer, counter)
+	}, counter)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	previous := c.reg
+	c.reg = reg
+	c.mu.Unlock()
+
+	if previous != nil {
+		return previous.Unregister()
+	}
+	return nil
+}
+
+// run checkpoints the values every interval until the counter is closed.
+func (c *PersistentCounter) run() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			if err := c.Checkpoint(); err != nil {
+				otel.Handle(err)
+			}
+		}
+	}
+}
+
+// observe reports the value of every series with counter.
+func (c *PersistentCounter) observe(observer metric.Observer, counter metric.Float64ObservableCounter) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, s := range c.series {
+		observer.ObserveFloat64(counter, s.value, metric.WithAttributeSet(s.set))
+	}
+
+	return nil
+}
+
+// restore loads the checkpoint file, or initializes the counter with the offset
+// when it doesn't exist.
+func (c *PersistentCounter) restore() error {
+	b, err := os.ReadFile(c.cfg.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		if c.cfg.Offset > 0 {
+			c.Add(c.cfg.Offset)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var series []checkpointSeries
+	if err := json.Unmarshal(b, &series); err != nil {
+		return fmt.Errorf("persistent counter: invalid checkpoint %s: %w", c.cfg.Path, err)
+	}
+
+	for _, s := range series {
+		c.Add(s.Value, attrcodec.Decode(s.Attributes)...)
+	}
+
+	return nil
+}
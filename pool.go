@@ -0,0 +1,154 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// PoolConfig describes an instrumented worker pool.
+	PoolConfig struct {
+		// Name identifies the pool, reported as the pool attribute.
+		Name string
+		// Size is the number of workers of the pool. If not positive, the pool is sized
+		// after the CPUs and its size follows GOMAXPROCS.
+		Size int
+		// QueueDepth returns the number of work items waiting for a worker, such as
+		// len(queue) for a channel. It must be safe for concurrent use. Optional.
+		QueueDepth func() int
+		// QueueCapacity is the capacity of the work queue, such as cap(queue) for a channel.
+		QueueCapacity int
+	}
+
+	// WorkerPool exports the USE metrics of a worker pool as gauges with the pool attribute:
+	//   - pool.workers.busy and pool.workers.size: the busy workers and the pool size
+	//   - pool.utilization: busy workers / pool size
+	//   - pool.queue.depth and pool.saturation: the queued work items and queue depth /
+	//     capacity, when the queue is configured
+	//
+	// The workers call Begin and End around each work item. A WorkerPool is safe for
+	// concurrent use.
+	WorkerPool struct {
+		cfg  PoolConfig
+		set  attribute.Set
+		busy atomic.Int64
+		size atomic.Int64
+
+		registration metric.Registration
+	}
+)
+
+// NewWorkerPool creates a WorkerPool and registers its gauges with the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//   - cfg: The pool description
+//
+// Returns:
+//   - A new WorkerPool
+//   - An error if the gauges or their callback cannot be registered
+func NewWorkerPool(meter metric.Meter, cfg PoolConfig) (*WorkerPool, error) {
+	p := &WorkerPool{cfg: cfg, set: attribute.NewSet(attribute.String("pool", cfg.Name))}
+	p.size.Store(int64(cfg.Size))
+
+	busy, err := meter.Int64ObservableGauge("pool.workers.busy", metric.WithDescription("Number of workers processing a work item."), metric.WithUnit("{worker}"))
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := meter.Int64ObservableGauge("pool.workers.size", metric.WithDescription("Number of workers of the pool."), metric.WithUnit("{worker}"))
+	if err != nil {
+		return nil, err
+	}
+
+	utilization, err := meter.Float64ObservableGauge("pool.utilization", metric.WithDescription("Share of the workers processing a work item."), metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	instruments := []metric.Observable{busy, size, utilization}
+
+	var depth metric.Int64ObservableGauge
+	var saturation metric.Float64ObservableGauge
+	if cfg.QueueDepth != nil {
+		depth, err = meter.Int64ObservableGauge("pool.queue.depth", metric.WithDescription("Number of work items waiting for a worker."), metric.WithUnit("{item}"))
+		if err != nil {
+			return nil, err
+		}
+
+		saturation, err = meter.Float64ObservableGauge("pool.saturation", metric.WithDescription("Share of the work queue capacity in use."), metric.WithUnit("1"))
+		if err != nil {
+			return nil, err
+		}
+
+		instruments = append(instruments, depth, saturation)
+	}
+
+	p.registration, err = meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		opt := metric.WithAttributeSet(p.set)
+
+		busyWorkers, workers := p.busy.Load(), p.Size()
+		observer.ObserveInt64(busy, busyWorkers, opt)
+		observer.ObserveInt64(size, workers, opt)
+		if workers > 0 {
+			observer.ObserveFloat64(utilization, float64(busyWorkers)/float64(workers), opt)
+		}
+
+		if cfg.QueueDepth != nil {
+			queued := cfg.QueueDepth()
+			observer.ObserveInt64(depth, int64(queued), opt)
+			if cfg.QueueCapacity > 0 {
+				observer.ObserveFloat64(saturation, float64(queued)/float64(cfg.QueueCapacity), opt)
+			}
+		}
+		return nil
+	}, instruments...)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Begin marks a worker as busy, it must be followed by End once the work item is done.
+func (p *WorkerPool) Begin() {
+	p.busy.Add(1)
+}
+
+// End marks a busy worker as idle.
+func (p *WorkerPool) End() {
+	p.busy.Add(-1)
+}
+
+// Resize sets the number of workers of a pool that grows or shrinks. A size that is not
+// positive makes the size follow GOMAXPROCS.
+//
+// Parameters:
+//   - size: The new number of workers
+func (p *WorkerPool) Resize(size int) {
+	p.size.Store(int64(size))
+}
+
+// Size returns the number of workers of the pool, GOMAXPROCS for pools sized after the CPUs.
+func (p *WorkerPool) Size() int64 {
+	if size := p.size.Load(); size > 0 {
+		return size
+	}
+	return int64(runtime.GOMAXPROCS(0))
+}
+
+// Unregister stops reporting the pool gauges.
+//
+// Returns:
+//   - An error if the callback cannot be unregistered
+func (p *WorkerPool) Unregister() error {
+	return p.registration.Unregister()
+}
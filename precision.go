@@ -0,0 +1,138 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/internal/window"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// PrecisionHistogram combines a histogram with the <name>.max and <name>.min gauges
+	// reporting the exact extrema recorded during each collection interval, so p100
+	// spikes aren't hidden by the bucket boundaries.
+	//
+	// The extrema gauges are produced by WindowProducer, in the github.com/goxkit/metrics
+	// scope, and reset on every collection of the exporting reader only.
+	//
+	// A PrecisionHistogram is safe for concurrent use.
+	PrecisionHistogram struct {
+		histogram metric.Float64Histogram
+
+		mu      sync.Mutex
+		extrema map[attribute.Distinct]*extrema
+
+		unregister func()
+	}
+
+	// extrema holds the extrema recorded for an attribute set during an interval.
+	extrema struct {
+		set      attribute.Set
+		min, max float64
+	}
+)
+
+// NewPrecisionHistogram creates a PrecisionHistogram with instruments created by the
+// given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//   - name: The histogram name, also prefixing the extrema gauges
+//   - opts: The histogram options, such as the unit or the bucket boundaries
+//
+// Returns:
+//   - A new PrecisionHistogram
+//   - An error if the histogram or the extrema gauges cannot be registered
+func NewPrecisionHistogram(meter metric.Meter, name string, opts ...metric.Float64HistogramOption) (*PrecisionHistogram, error) {
+	histogram, err := meter.Float64Histogram(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &PrecisionHistogram{
+		histogram: histogram,
+		extrema:   map[attribute.Distinct]*extrema{},
+	}
+
+	// The gauges share the unit of the histogram
+	unit := metric.NewFloat64HistogramConfig(opts...).Unit()
+
+	names := []string{name + ".max", name + ".min"}
+	unregister, err := window.Default.Register(instrumentationScope, names, func(start, now time.Time) []metricdata.Metrics {
+		current := h.collect()
+		if len(current) == 0 {
+			return nil
+		}
+
+		maxs := make([]metricdata.DataPoint[float64], 0, len(current))
+		mins := make([]metricdata.DataPoint[float64], 0, len(current))
+		for _, e := range current {
+			maxs = append(maxs, windowPoint(e.set, start, now, e.max))
+			mins = append(mins, windowPoint(e.set, start, now, e.min))
+		}
+
+		return []metricdata.Metrics{
+			window.Gauge(names[0], "Maximum value recorded during the collection interval.", unit, maxs),
+			window.Gauge(names[1], "Minimum value recorded during the collection interval.", unit, mins),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.unregister = unregister
+
+	return h, nil
+}
+
+// Record records value in the histogram and updates the extrema of the interval.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - value: The value to record
+//   - attrs: The attributes of the measurement
+func (h *PrecisionHistogram) Record(ctx context.Context, value float64, attrs ...attribute.KeyValue) {
+	set := attribute.NewSet(attrs...)
+	h.histogram.Record(ctx, value, metric.WithAttributeSet(set))
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e, ok := h.extrema[set.Equivalent()]
+	if !ok {
+		h.extrema[set.Equivalent()] = &extrema{set: set, min: value, max: value}
+		return
+	}
+	if value < e.min {
+		e.min = value
+	}
+	if value > e.max {
+		e.max = value
+	}
+}
+
+// Unregister stops exporting the extrema gauges, the histogram isn't affected.
+//
+// Returns:
+//   - Always nil, the error is kept for the symmetry with the other registrations
+func (h *PrecisionHistogram) Unregister() error {
+	h.unregister()
+	return nil
+}
+
+// collect returns the extrema of the interval, then resets them.
+func (h *PrecisionHistogram) collect() map[attribute.Distinct]*extrema {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	current := h.extrema
+	h.extrema = make(map[attribute.Distinct]*extrema, len(current))
+	return current
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/goxkit/metrics/buckets"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// WithBucketPreset sets the bucket layout of the histograms created by the Recorder to
+// a curated preset, such as buckets.DBQuery. Without names, the preset applies to every
+// histogram without a preset of its own. The boundaries follow the unit of the duration
+// histograms, and are in seconds for the histograms recorded with Record.
+//
+// Parameters:
+//   - preset: The bucket preset
+//   - names: The histogram names the preset applies to, all of them if empty
+//
+// Returns:
+//   - A RecorderOption setting the bucket preset
+func WithBucketPreset(preset buckets.Preset, names ...string) RecorderOption {
+	return func(r *Recorder) {
+		if len(names) == 0 {
+			r.defaultBucketPreset = preset
+			return
+		}
+
+		if r.bucketPresets == nil {
+			r.bucketPresets = map[string]buckets.Preset{}
+		}
+		for _, name := range names {
+			r.bucketPresets[name] = preset
+		}
+	}
+}
+
+// histogramOptions returns the options of the named histogram, with the bucket
+// boundaries of its preset expressed in unit.
+func (r *Recorder) histogramOptions(name string, unit time.Duration, opts ...metric.Float64HistogramOption) []metric.Float64HistogramOption {
+	preset, ok := r.bucketPresets[name]
+	if !ok {
+		preset = r.defaultBucketPreset
+	}
+
+	if boundaries := preset.In(unit); len(boundaries) > 0 {
+		opts = append(opts, metric.WithExplicitBucketBoundaries(boundaries...))
+	}
+	return opts
+}
+
+// duration returns the duration of one unit, one second for an unknown unit.
+func (u Unit) duration() time.Duration {
+	switch u {
+	case Nanoseconds:
+		return time.Nanosecond
+	case Microseconds:
+		return time.Microsecond
+	case Milliseconds:
+		return time.Millisecond
+	default:
+		return time.Second
+	}
+}
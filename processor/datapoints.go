@@ -0,0 +1,180 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// MapAttributes replaces the attribute set of every data point of the aggregation
+// with the result of fn. All the aggregation types produced by the SDK are supported.
+//
+// Parameters:
+//   - data: The aggregation whose data points are modified in place
+//   - fn: The function mapping the current attributes to the new ones
+func MapAttributes(data metricdata.Aggregation, fn func(attribute.Set) attribute.Set) {
+	switch a := data.(type) {
+	case metricdata.Gauge[int64]:
+		mapDataPoints(a.DataPoints, fn)
+	case metricdata.Gauge[float64]:
+		mapDataPoints(a.DataPoints, fn)
+	case metricdata.Sum[int64]:
+		mapDataPoints(a.DataPoints, fn)
+	case metricdata.Sum[float64]:
+		mapDataPoints(a.DataPoints, fn)
+	case metricdata.Histogram[int64]:
+		mapHistogramDataPoints(a.DataPoints, fn)
+	case metricdata.Histogram[float64]:
+		mapHistogramDataPoints(a.DataPoints, fn)
+	case metricdata.ExponentialHistogram[int64]:
+		mapExponentialHistogramDataPoints(a.DataPoints, fn)
+	case metricdata.ExponentialHistogram[float64]:
+		mapExponentialHistogramDataPoints(a.DataPoints, fn)
+	case metricdata.Summary:
+		for i := range a.DataPoints {
+			a.DataPoints[i].Attributes = fn(a.DataPoints[i].Attributes)
+		}
+	}
+}
+
+func mapDataPoints[N int64 | float64](dps []metricdata.DataPoint[N], fn func(attribute.Set) attribute.Set) {
+	for i := range dps {
+		dps[i].Attributes = fn(dps[i].Attributes)
+	}
+}
+
+func mapHistogramDataPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N], fn func(attribute.Set) attribute.Set) {
+	for i := range dps {
+		dps[i].Attributes = fn(dps[i].Attributes)
+	}
+}
+
+func mapExponentialHistogramDataPoints[N int64 | float64](dps []metricdata.ExponentialHistogramDataPoint[N], fn func(attribute.Set) attribute.Set) {
+	for i := range dps {
+		dps[i].Attributes = fn(dps[i].Attributes)
+	}
+}
+
+// mergeDataPoints merges the data points sharing the same attribute set, which may
+// happen after their attributes were reduced. Sums are added, gauges keep the latest
+// value and histograms with the same bounds are combined. Exponential histograms and
+// summaries are returned unchanged. The merged data points are copied to a new slice,
+// the data points of the collected metrics being reused by the reader.
+func mergeDataPoints(data metricdata.Aggregation) metricdata.Aggregation {
+	switch a := data.(type) {
+	case metricdata.Gauge[int64]:
+		a.DataPoints = mergeGaugeDataPoints(a.DataPoints)
+		return a
+	case metricdata.Gauge[float64]:
+		a.DataPoints = mergeGaugeDataPoints(a.DataPoints)
+		return a
+	case metricdata.Sum[int64]:
+		a.DataPoints = mergeSumDataPoints(a.DataPoints)
+		return a
+	case metricdata.Sum[float64]:
+		a.DataPoints = mergeSumDataPoints(a.DataPoints)
+		return a
+	case metricdata.Histogram[int64]:
+		a.DataPoints = mergeHistogramDataPoints(a.DataPoints)
+		return a
+	case metricdata.Histogram[float64]:
+		a.DataPoints = mergeHistogramDataPoints(a.DataPoints)
+		return a
+	}
+
+	return data
+}
+
+func mergeSumDataPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	index := make(map[attribute.Distinct]int, len(dps))
+	merged := make([]metricdata.DataPoint[N], 0, len(dps))
+	for _, dp := range dps {
+		i, ok := index[dp.Attributes.Equivalent()]
+		if !ok {
+			index[dp.Attributes.Equivalent()] = len(merged)
+			merged = append(merged, dp)
+			continue
+		}
+
+		m := &merged[i]
+		m.Value += dp.Value
+		m.Exemplars = append(slices.Clip(m.Exemplars), dp.Exemplars...)
+		if dp.StartTime.Before(m.StartTime) {
+			m.StartTime = dp.StartTime
+		}
+		if dp.Time.After(m.Time) {
+			m.Time = dp.Time
+		}
+	}
+	return merged
+}
+
+func mergeGaugeDataPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	index := make(map[attribute.Distinct]int, len(dps))
+	merged := make([]metricdata.DataPoint[N], 0, len(dps))
+	for _, dp := range dps {
+		i, ok := index[dp.Attributes.Equivalent()]
+		if !ok {
+			index[dp.Attributes.Equivalent()] = len(merged)
+			merged = append(merged, dp)
+			continue
+		}
+
+		if dp.Time.After(merged[i].Time) {
+			merged[i] = dp
+		}
+	}
+	return merged
+}
+
+func mergeHistogramDataPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []metricdata.HistogramDataPoint[N] {
+	index := make(map[attribute.Distinct]int, len(dps))
+	merged := make([]metricdata.HistogramDataPoint[N], 0, len(dps))
+	for _, dp := range dps {
+		i, ok := index[dp.Attributes.Equivalent()]
+		if !ok || !slices.Equal(merged[i].Bounds, dp.Bounds) {
+			index[dp.Attributes.Equivalent()] = len(merged)
+			merged = append(merged, dp)
+			continue
+		}
+
+		m := &merged[i]
+		// The bucket counts may be shared with the SDK, they are copied before being modified
+		counts := slices.Clone(m.BucketCounts)
+		for j := range counts {
+			counts[j] += dp.BucketCounts[j]
+		}
+		m.BucketCounts = counts
+		m.Count += dp.Count
+		m.Sum += dp.Sum
+		m.Min = mergeExtrema(m.Min, dp.Min, func(a, b N) bool { return a < b })
+		m.Max = mergeExtrema(m.Max, dp.Max, func(a, b N) bool { return a > b })
+		m.Exemplars = append(slices.Clip(m.Exemplars), dp.Exemplars...)
+		if dp.StartTime.Before(m.StartTime) {
+			m.StartTime = dp.StartTime
+		}
+		if dp.Time.After(m.Time) {
+			m.Time = dp.Time
+		}
+	}
+	return merged
+}
+
+// mergeExtrema returns the extremum of a and b, better reporting whether the first
+// value is the extremum.
+func mergeExtrema[N int64 | float64](a, b metricdata.Extrema[N], better func(a, b N) bool) metricdata.Extrema[N] {
+	av, aok := a.Value()
+	bv, bok := b.Value()
+	switch {
+	case !bok:
+		return a
+	case !aok || better(bv, av):
+		return b
+	}
+	return a
+}
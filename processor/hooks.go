@@ -0,0 +1,109 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"path"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// RenameMetrics returns a hook rewriting the name of every metric with fn.
+// Returning the same name keeps the metric unchanged.
+//
+// Parameters:
+//   - fn: The function mapping the current name to the exported name
+//
+// Returns:
+//   - A Hook renaming the metrics
+func RenameMetrics(fn func(name string) string) Hook {
+	return HookFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		for i := range rm.ScopeMetrics {
+			for j := range rm.ScopeMetrics[i].Metrics {
+				rm.ScopeMetrics[i].Metrics[j].Name = fn(rm.ScopeMetrics[i].Metrics[j].Name)
+			}
+		}
+		return nil
+	})
+}
+
+// DropMetrics returns a hook removing the metrics whose name matches any of the
+// patterns. Patterns use the path.Match syntax, for example "http.*".
+// Malformed patterns never match.
+//
+// Parameters:
+//   - patterns: The name patterns of the metrics to drop
+//
+// Returns:
+//   - A Hook dropping the matching metrics
+func DropMetrics(patterns ...string) Hook {
+	return FilterMetrics(func(m metricdata.Metrics) bool {
+		return !matchAny(patterns, m.Name)
+	})
+}
+
+// FilterMetrics returns a hook keeping only the metrics for which keep returns true.
+// The kept metrics are copied to new slices: the slices of the collected metrics are
+// owned by the reader, which reuses their aggregations on the next collection, so
+// compacting them in place would corrupt the following exports.
+//
+// Parameters:
+//   - keep: The function reporting whether a metric is exported
+//
+// Returns:
+//   - A Hook filtering the metrics
+func FilterMetrics(keep func(m metricdata.Metrics) bool) Hook {
+	return HookFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		scopes := make([]metricdata.ScopeMetrics, 0, len(rm.ScopeMetrics))
+		for _, sm := range rm.ScopeMetrics {
+			metrics := make([]metricdata.Metrics, 0, len(sm.Metrics))
+			for _, m := range sm.Metrics {
+				if keep(m) {
+					metrics = append(metrics, m)
+				}
+			}
+			scopes = append(scopes, metricdata.ScopeMetrics{Scope: sm.Scope, Metrics: metrics})
+		}
+		rm.ScopeMetrics = scopes
+		return nil
+	})
+}
+
+// InjectAttributes returns a hook adding static attributes to every data point.
+// Attributes already recorded with the same key take precedence.
+//
+// Parameters:
+//   - attrs: The attributes to add
+//
+// Returns:
+//   - A Hook adding the attributes
+func InjectAttributes(attrs ...attribute.KeyValue) Hook {
+	return HookFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		for i := range rm.ScopeMetrics {
+			for j := range rm.ScopeMetrics[i].Metrics {
+				MapAttributes(rm.ScopeMetrics[i].Metrics[j].Data, func(set attribute.Set) attribute.Set {
+					kvs := make([]attribute.KeyValue, 0, len(attrs)+set.Len())
+					kvs = append(kvs, attrs...)
+					// attribute.NewSet keeps the last value of duplicated keys
+					kvs = append(kvs, set.ToSlice()...)
+					return attribute.NewSet(kvs...)
+				})
+			}
+		}
+		return nil
+	})
+}
+
+// matchAny reports whether name matches any of the patterns.
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, err := path.Match(p, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
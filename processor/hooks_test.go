@@ -0,0 +1,111 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// recordingExporter records the counter values of every export.
+type recordingExporter struct {
+	exports []map[string]int64
+}
+
+func (e *recordingExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (e *recordingExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *recordingExporter) Export(_ context.Context, rm *metricdata.ResourceMetrics) error {
+	values := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, dp := range sum.DataPoints {
+					values[m.Name] += dp.Value
+				}
+			}
+		}
+	}
+	e.exports = append(e.exports, values)
+	return nil
+}
+
+func (e *recordingExporter) ForceFlush(context.Context) error { return nil }
+
+func (e *recordingExporter) Shutdown(context.Context) error { return nil }
+
+// TestDropMetricsKeepsReaderSlices verifies that dropping a metric doesn't corrupt the
+// values of the kept metrics on the following collections, which reuse the slices of
+// the previous ones.
+func TestDropMetricsKeepsReaderSlices(t *testing.T) {
+	ctx := context.Background()
+	exp := &recordingExporter{}
+
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(sdkmetric.NewPeriodicReader(NewExporter(exp, DropMetrics("drop_*")))))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	meter := provider.Meter("test")
+	for _, c := range []struct {
+		name  string
+		value int64
+	}{{"drop_a", 100}, {"keep_b", 1}, {"keep_c", 10}} {
+		counter, err := meter.Int64Counter(c.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counter.Add(ctx, c.value)
+	}
+
+	const flushes = 3
+	for i := 0; i < flushes; i++ {
+		if err := provider.ForceFlush(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(exp.exports) != flushes {
+		t.Fatalf("got %d exports, want %d", len(exp.exports), flushes)
+	}
+	for i, values := range exp.exports {
+		if _, ok := values["drop_a"]; ok {
+			t.Errorf("export %d: drop_a not dropped", i)
+		}
+		if values["keep_b"] != 1 || values["keep_c"] != 10 {
+			t.Errorf("export %d: got keep_b=%d keep_c=%d, want keep_b=1 keep_c=10", i, values["keep_b"], values["keep_c"])
+		}
+	}
+}
+
+// TestFilterMetricsKeepsReaderSlices verifies that the hook doesn't write into the
+// slices of the filtered ResourceMetrics.
+func TestFilterMetricsKeepsReaderSlices(t *testing.T) {
+	metrics := []metricdata.Metrics{{Name: "drop_a"}, {Name: "keep_b"}, {Name: "keep_c"}}
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: metrics}}}
+	scopes := rm.ScopeMetrics
+
+	if err := DropMetrics("drop_*").Process(context.Background(), rm); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(rm.ScopeMetrics[0].Metrics); got != 2 {
+		t.Fatalf("got %d metrics, want 2", got)
+	}
+	for i, want := range []string{"drop_a", "keep_b", "keep_c"} {
+		if metrics[i].Name != want {
+			t.Errorf("reader slice modified: metrics[%d] = %s, want %s", i, metrics[i].Name, want)
+		}
+	}
+	if len(scopes[0].Metrics) != 3 {
+		t.Errorf("reader scope modified: got %d metrics, want 3", len(scopes[0].Metrics))
+	}
+}
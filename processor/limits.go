@@ -0,0 +1,225 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// AttributeCountLimitEnvKey is the environment variable setting the maximum number
+	// of attributes per data point. Default: no limit
+	AttributeCountLimitEnvKey = "OTEL_ATTRIBUTE_COUNT_LIMIT"
+
+	// AttributeValueLengthLimitEnvKey is the environment variable setting the maximum
+	// length of the string attribute values. Default: no limit
+	AttributeValueLengthLimitEnvKey = "OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT"
+)
+
+// AttributeLimits bounds the attributes of the exported data points. Zero values
+// mean no limit.
+type AttributeLimits struct {
+	// CountLimit is the maximum number of attributes per data point, the attributes
+	// are kept in key order.
+	CountLimit int
+	// ValueLengthLimit is the maximum length, in characters, of the string values,
+	// longer values are truncated.
+	ValueLengthLimit int
+	// Overrides replace the limits of the metrics whose name matches the key,
+	// using the path.Match syntax. The first matching pattern in key order applies.
+	Overrides map[string]AttributeLimits
+}
+
+// AttributeLimitsFromEnv reads the limits from the OTEL_ATTRIBUTE_COUNT_LIMIT and
+// OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variables, invalid values are ignored.
+// The otlp Install enforces them on the exported metrics.
+//
+// Returns:
+//   - The limits configured in the environment
+func AttributeLimitsFromEnv() AttributeLimits {
+	var limits AttributeLimits
+	if v, err := strconv.Atoi(os.Getenv(AttributeCountLimitEnvKey)); err == nil && v > 0 {
+		limits.CountLimit = v
+	}
+	if v, err := strconv.Atoi(os.Getenv(AttributeValueLengthLimitEnvKey)); err == nil && v > 0 {
+		limits.ValueLengthLimit = v
+	}
+	return limits
+}
+
+// LimitAttributes returns a hook enforcing the attribute limits on every data point,
+// protecting the backend from huge header values or unbounded strings recorded as
+// attributes. Data points whose attributes become identical are merged, except for
+// exponential histograms and summaries.
+//
+// Parameters:
+//   - limits: The default limits and the per-metric overrides
+//
+// Returns:
+//   - A Hook limiting the attributes
+func LimitAttributes(limits AttributeLimits) Hook {
+	patterns := make([]string, 0, len(limits.Overrides))
+	for p := range limits.Overrides {
+		patterns = append(patterns, p)
+	}
+	sort.Strings(patterns)
+
+	return HookFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		for i := range rm.ScopeMetrics {
+			for j := range rm.ScopeMetrics[i].Metrics {
+				m := &rm.ScopeMetrics[i].Metrics[j]
+
+				l := limits
+				for _, p := range patterns {
+					if ok, err := path.Match(p, m.Name); err == nil && ok {
+						l = limits.Overrides[p]
+						break
+					}
+				}
+				if l.CountLimit <= 0 && l.ValueLengthLimit <= 0 {
+					continue
+				}
+
+				changed := false
+				MapAttributes(m.Data, func(set attribute.Set) attribute.Set {
+					limited, ok := l.apply(set)
+					changed = changed || ok
+					return limited
+				})
+				if changed {
+					m.Data = mergeDataPoints(m.Data)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// overflowSet is the attribute set of the data points exceeding the cardinality limit,
+// the one of the SDK cardinality limit.
+var overflowSet = attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+
+// LimitCardinality returns a hook bounding the number of exported attribute sets per
+// metric, like the OTEL_GO_X_CARDINALITY_LIMIT environment variable of the SDK: the
+// first limit - 1 attribute sets exported by a metric are kept, and the data points of
+// the other sets are merged in the otel.metric.overflow set. A set is kept or merged
+// for the lifetime of the hook, so the cumulative overflow sums stay monotonic. Unlike
+// the SDK limit, the hook only bounds the export, not the memory of the aggregations.
+//
+// Parameters:
+//   - limit: The maximum number of attribute sets per metric, overflow set included
+//
+// Returns:
+//   - A Hook limiting the cardinality, or a hook doing nothing if limit isn't positive
+func LimitCardinality(limit int) Hook {
+	var (
+		mu   sync.Mutex
+		kept = map[string]map[attribute.Distinct]struct{}{}
+	)
+
+	return HookFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		if limit <= 0 {
+			return nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for i := range rm.ScopeMetrics {
+			for j := range rm.ScopeMetrics[i].Metrics {
+				m := &rm.ScopeMetrics[i].Metrics[j]
+
+				key := rm.ScopeMetrics[i].Scope.Name + "/" + m.Name
+				sets, ok := kept[key]
+				if !ok {
+					sets = map[attribute.Distinct]struct{}{}
+					kept[key] = sets
+				}
+
+				changed := false
+				MapAttributes(m.Data, func(set attribute.Set) attribute.Set {
+					if _, ok := sets[set.Equivalent()]; ok || set.Equivalent() == overflowSet.Equivalent() {
+						return set
+					}
+					if len(sets) < limit-1 {
+						sets[set.Equivalent()] = struct{}{}
+						return set
+					}
+					changed = true
+					return overflowSet
+				})
+				if changed {
+					m.Data = mergeDataPoints(m.Data)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// apply returns the attribute set within the limits, reporting whether it changed.
+func (l AttributeLimits) apply(set attribute.Set) (attribute.Set, bool) {
+	kvs := set.ToSlice()
+	changed := false
+
+	if l.CountLimit > 0 && len(kvs) > l.CountLimit {
+		kvs = kvs[:l.CountLimit]
+		changed = true
+	}
+
+	if l.ValueLengthLimit > 0 {
+		for i, kv := range kvs {
+			switch kv.Value.Type() {
+			case attribute.STRING:
+				if s, ok := truncate(kv.Value.AsString(), l.ValueLengthLimit); ok {
+					kvs[i] = kv.Key.String(s)
+					changed = true
+				}
+			case attribute.STRINGSLICE:
+				values := kv.Value.AsStringSlice()
+				truncated := false
+				for k, v := range values {
+					if s, ok := truncate(v, l.ValueLengthLimit); ok {
+						values[k], truncated = s, true
+					}
+				}
+				if truncated {
+					kvs[i] = kv.Key.StringSlice(values)
+					changed = true
+				}
+			}
+		}
+	}
+
+	if !changed {
+		return set, false
+	}
+	return attribute.NewSet(kvs...), true
+}
+
+// truncate truncates s to limit characters, reporting whether it was truncated.
+func truncate(s string, limit int) (string, bool) {
+	if utf8.RuneCountInString(s) <= limit {
+		return s, false
+	}
+
+	n := 0
+	for i := range s {
+		if n == limit {
+			return s[:i], true
+		}
+		n++
+	}
+	return s, false
+}
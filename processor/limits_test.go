@@ -0,0 +1,123 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// exemplarExporter checks that the exemplars of every exported histogram data point
+// are within the data point extrema.
+type exemplarExporter struct {
+	recordingExporter
+	exports int
+	// misplaced counts the exemplars outside the extrema of their data point.
+	misplaced int
+}
+
+func (e *exemplarExporter) Export(_ context.Context, rm *metricdata.ResourceMetrics) error {
+	e.exports++
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			h, ok := m.Data.(metricdata.Histogram[int64])
+			if !ok {
+				continue
+			}
+			for _, dp := range h.DataPoints {
+				lo, _ := dp.Min.Value()
+				hi, _ := dp.Max.Value()
+				for _, ex := range dp.Exemplars {
+					if ex.Value < lo || ex.Value > hi {
+						e.misplaced++
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// TestLimitAttributesMergesOverFlushes verifies that the data points merged after the
+// attributes were limited don't alias the data points reused by the reader on the
+// following collections.
+func TestLimitAttributesMergesOverFlushes(t *testing.T) {
+	ctx := context.Background()
+	exp := &exemplarExporter{}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(NewExporter(exp, LimitAttributes(AttributeLimits{CountLimit: 1})))),
+		sdkmetric.WithExemplarFilter(exemplar.AlwaysOnFilter),
+	)
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	histogram, err := provider.Meter("test").Int64Histogram("latency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, series := range []struct {
+		a, b  string
+		value int64
+	}{{"1", "x", 1}, {"1", "y", 2}, {"2", "x", 300}, {"3", "x", 4000}} {
+		histogram.Record(ctx, series.value, metric.WithAttributes(attribute.String("a", series.a), attribute.String("b", series.b)))
+	}
+
+	const flushes = 5
+	for i := 0; i < flushes; i++ {
+		if err := provider.ForceFlush(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if exp.exports != flushes {
+		t.Fatalf("got %d exports, want %d", exp.exports, flushes)
+	}
+	if exp.misplaced > 0 {
+		t.Errorf("got %d exemplars outside the extrema of their data point", exp.misplaced)
+	}
+}
+
+// TestLimitCardinality verifies that the attribute sets beyond the limit are merged in
+// the overflow set, and that the kept sets stay the same across exports.
+func TestLimitCardinality(t *testing.T) {
+	ctx := context.Background()
+	hook := LimitCardinality(3)
+
+	export := func(users ...string) map[string]int64 {
+		dps := make([]metricdata.DataPoint[int64], 0, len(users))
+		for _, u := range users {
+			dps = append(dps, metricdata.DataPoint[int64]{Attributes: attribute.NewSet(attribute.String("user", u)), Value: 1})
+		}
+		rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{{
+			Name: "logins",
+			Data: metricdata.Sum[int64]{Temporality: metricdata.CumulativeTemporality, IsMonotonic: true, DataPoints: dps},
+		}}}}}
+		if err := hook.Process(ctx, rm); err != nil {
+			t.Fatal(err)
+		}
+
+		values := map[string]int64{}
+		for _, dp := range rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64]).DataPoints {
+			values[dp.Attributes.Encoded(attribute.DefaultEncoder())] = dp.Value
+		}
+		return values
+	}
+
+	first := export("ada", "bob", "eve", "joe")
+	if len(first) != 3 || first["otel.metric.overflow=true"] != 2 {
+		t.Fatalf("got %v, want ada, bob and the overflow set of 2", first)
+	}
+
+	second := export("eve", "bob", "ada")
+	if len(second) != 3 || second["otel.metric.overflow=true"] != 1 || second["user=ada"] != 1 || second["user=bob"] != 1 {
+		t.Fatalf("got %v, want ada, bob and the overflow set of 1", second)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"strings"
+)
+
+// Backends with built-in name rules.
+const (
+	// BackendOTLP follows the OpenTelemetry instrument name syntax.
+	BackendOTLP Backend = "otlp"
+	// BackendPrometheus follows the Prometheus metric name syntax.
+	BackendPrometheus Backend = "prometheus"
+	// BackendStatsD follows the StatsD metric name syntax.
+	BackendStatsD Backend = "statsd"
+)
+
+type (
+	// Backend identifies a metrics backend with its own naming rules.
+	Backend string
+
+	// NameRules describes the metric names accepted by a backend.
+	NameRules struct {
+		// Separator replaces the dots used as namespace separators, kept if zero.
+		Separator rune
+		// Valid reports whether r is accepted at position i of the name.
+		Valid func(r rune, i int) bool
+		// Replacement replaces the invalid characters.
+		Replacement rune
+		// Prefix is prepended when the first character is invalid but valid later.
+		Prefix string
+		// MaxLength truncates longer names, no limit if zero.
+		MaxLength int
+	}
+)
+
+// NameRulesFor returns the name rules of the backend. Unknown backends use the
+// OTLP rules.
+//
+// Parameters:
+//   - backend: The target backend
+//
+// Returns:
+//   - The name rules of the backend
+func NameRulesFor(backend Backend) NameRules {
+	switch backend {
+	case BackendPrometheus:
+		// [a-zA-Z_:][a-zA-Z0-9_:]*
+		return NameRules{
+			Separator:   '_',
+			Replacement: '_',
+			Prefix:      "_",
+			Valid: func(r rune, i int) bool {
+				return isLetter(r) || r == '_' || r == ':' || (i > 0 && isDigit(r))
+			},
+		}
+	case BackendStatsD:
+		// ':', '|' and '@' are reserved by the line protocol, dots are hierarchy separators
+		return NameRules{
+			Replacement: '_',
+			Valid: func(r rune, _ int) bool {
+				return isLetter(r) || isDigit(r) || r == '_' || r == '.' || r == '-'
+			},
+			// Common limit of the StatsD agents, such as the Datadog agent
+			MaxLength: 200,
+		}
+	default:
+		// [a-zA-Z][a-zA-Z0-9_.\-/]{0,254}
+		return NameRules{
+			Replacement: '_',
+			Prefix:      "m",
+			Valid: func(r rune, i int) bool {
+				return isLetter(r) || (i > 0 && (isDigit(r) || r == '_' || r == '.' || r == '-' || r == '/'))
+			},
+			MaxLength: 255,
+		}
+	}
+}
+
+// Sanitize converts name to a name accepted by the rules.
+//
+// Parameters:
+//   - name: The instrument name
+//
+// Returns:
+//   - The sanitized name
+func (n NameRules) Sanitize(name string) string {
+	var b strings.Builder
+	b.Grow(len(name) + len(n.Prefix))
+
+	for i, r := range name {
+		if r == '.' && n.Separator != 0 {
+			r = n.Separator
+		}
+
+		if i == 0 && !n.valid(r, 0) && n.valid(r, 1) {
+			b.WriteString(n.Prefix)
+		}
+
+		if !n.valid(r, b.Len()) {
+			r = n.Replacement
+		}
+		b.WriteRune(r)
+	}
+
+	s := b.String()
+	if n.MaxLength > 0 && len(s) > n.MaxLength {
+		s = s[:n.MaxLength]
+	}
+
+	return s
+}
+
+// valid reports whether r is accepted at position i, any character if Valid is nil.
+func (n NameRules) valid(r rune, i int) bool {
+	return n.Valid == nil || n.Valid(r, i)
+}
+
+// SanitizeNames returns a hook converting the metric names to names accepted by the
+// backend, so instrumentation code stays backend-agnostic.
+//
+// Parameters:
+//   - backend: The target backend
+//
+// Returns:
+//   - A Hook renaming the metrics
+func SanitizeNames(backend Backend) Hook {
+	return RenameMetrics(NameRulesFor(backend).Sanitize)
+}
+
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package processor provides a wrapping exporter that runs processing hooks on the
+// collected metrics just before they are exported. It brings collector-like
+// processing, such as renaming, dropping or enriching metrics, to applications
+// exporting directly to a backend.
+package processor
+
+import (
+	"context"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// Hook processes the collected metrics before they are exported.
+	// Hooks may modify the ResourceMetrics in place, but must not compact or reorder
+	// its slices, which the reader reuses on the next collection: FilterMetrics copies
+	// the metrics it keeps instead. Returning an error aborts the export.
+	Hook interface {
+		// Process modifies the metrics about to be exported.
+		Process(ctx context.Context, rm *metricdata.ResourceMetrics) error
+	}
+
+	// HookFunc is an adapter allowing ordinary functions to be used as hooks.
+	HookFunc func(ctx context.Context, rm *metricdata.ResourceMetrics) error
+
+	// exporter wraps an sdkmetric.Exporter running the hooks before every export.
+	exporter struct {
+		sdkmetric.Exporter
+		hooks []Hook
+	}
+)
+
+// Process calls f(ctx, rm).
+func (f HookFunc) Process(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return f(ctx, rm)
+}
+
+// NewExporter wraps an exporter so that the hooks run, in the given order, on the
+// collected metrics before each export. Temporality, aggregation, flush and shutdown
+// are delegated to the wrapped exporter.
+//
+// Parameters:
+//   - next: The exporter receiving the processed metrics
+//   - hooks: The hooks to run before each export
+//
+// Returns:
+//   - An sdkmetric.Exporter running the hooks
+func NewExporter(next sdkmetric.Exporter, hooks ...Hook) sdkmetric.Exporter {
+	return &exporter{Exporter: next, hooks: hooks}
+}
+
+// Wrap returns an exporter wrapper running the hooks, to be used with
+// options.WithExporterWrapper.
+//
+// Parameters:
+//   - hooks: The hooks to run before each export
+//
+// Returns:
+//   - A function wrapping an exporter with NewExporter
+func Wrap(hooks ...Hook) func(sdkmetric.Exporter) sdkmetric.Exporter {
+	return func(next sdkmetric.Exporter) sdkmetric.Exporter {
+		return NewExporter(next, hooks...)
+	}
+}
+
+// Export runs the hooks and exports the resulting metrics. The hooks process a shallow
+// copy of rm, so the ResourceMetrics owned by the reader keeps its slices.
+func (e *exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	processed := *rm
+	for _, h := range e.hooks {
+		if err := h.Process(ctx, &processed); err != nil {
+			return err
+		}
+	}
+
+	return e.Exporter.Export(ctx, &processed)
+}
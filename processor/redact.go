@@ -0,0 +1,190 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	// RedactKeysEnvKey is the environment variable listing the comma-separated key
+	// patterns of the attributes to redact.
+	RedactKeysEnvKey = "METRICS_REDACT_KEYS"
+
+	// RedactedValue replaces the value of the redacted attributes.
+	RedactedValue = "[REDACTED]"
+)
+
+// Redaction lists the attributes whose values must never leave the process.
+type Redaction struct {
+	// Keys are the patterns of the attribute keys to redact, using the path.Match
+	// syntax and matched case-insensitively, for instance "*password*" or "*.token".
+	Keys []string
+	// Values are the expressions of the string values to redact whatever their key,
+	// for instance the format of the API keys.
+	Values []*regexp.Regexp
+}
+
+// RedactionFromEnv reads the key patterns to redact from the METRICS_REDACT_KEYS
+// environment variable. The otlp Install applies it with Redact and RedactViews.
+//
+// Returns:
+//   - The redaction configured in the environment
+func RedactionFromEnv() Redaction {
+	var r Redaction
+	for _, p := range strings.Split(os.Getenv(RedactKeysEnvKey), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			r.Keys = append(r.Keys, p)
+		}
+	}
+	return r
+}
+
+// Redact returns a hook replacing the values of the redacted attributes of the resource
+// and of every data point by RedactedValue, so secrets accidentally placed in attributes,
+// such as resource attributes derived from the environment, are never exported. Data
+// points whose attributes become identical are merged, except for exponential histograms
+// and summaries.
+//
+// Parameters:
+//   - r: The attributes to redact
+//
+// Returns:
+//   - A Hook redacting the attributes
+func Redact(r Redaction) Hook {
+	r = r.normalized()
+
+	return HookFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		if len(r.Keys) == 0 && len(r.Values) == 0 {
+			return nil
+		}
+
+		if rm.Resource != nil {
+			if set, ok := r.apply(*rm.Resource.Set()); ok {
+				rm.Resource = resource.NewWithAttributes(rm.Resource.SchemaURL(), set.ToSlice()...)
+			}
+		}
+
+		for i := range rm.ScopeMetrics {
+			for j := range rm.ScopeMetrics[i].Metrics {
+				m := &rm.ScopeMetrics[i].Metrics[j]
+
+				changed := false
+				MapAttributes(m.Data, func(set attribute.Set) attribute.Set {
+					redacted, ok := r.apply(set)
+					changed = changed || ok
+					return redacted
+				})
+				if changed {
+					m.Data = mergeDataPoints(m.Data)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// RedactViews wraps views so the streams they produce drop the redacted attributes,
+// and adds a view dropping them from the instruments no view matches. Unlike Redact,
+// which only processes the exported metrics, the views apply to every reader of the
+// MeterProvider, such as the Prometheus handler, the diagnostics endpoint or the
+// catalog. A view can't rewrite the attribute values: the redacted data point
+// attributes are dropped instead of replaced by RedactedValue. The metrics of the
+// producers don't go through the views, pair the views with Redact on the exporter.
+//
+// Parameters:
+//   - r: The attributes to redact
+//   - views: The views of the MeterProvider
+//
+// Returns:
+//   - The views of the MeterProvider dropping the redacted attributes
+func RedactViews(r Redaction, views ...sdkmetric.View) []sdkmetric.View {
+	r = r.normalized()
+	keep := func(kv attribute.KeyValue) bool { return !r.redacted(kv) }
+
+	redacted := make([]sdkmetric.View, 0, len(views)+1)
+	for _, v := range views {
+		redacted = append(redacted, func(i sdkmetric.Instrument) (sdkmetric.Stream, bool) {
+			stream, ok := v(i)
+			if ok {
+				filter := stream.AttributeFilter
+				stream.AttributeFilter = func(kv attribute.KeyValue) bool {
+					return (filter == nil || filter(kv)) && keep(kv)
+				}
+			}
+			return stream, ok
+		})
+	}
+
+	// The default stream of the instruments no view matches
+	redacted = append(redacted, func(i sdkmetric.Instrument) (sdkmetric.Stream, bool) {
+		for _, v := range views {
+			if _, ok := v(i); ok {
+				return sdkmetric.Stream{}, false
+			}
+		}
+		return sdkmetric.Stream{Name: i.Name, Description: i.Description, Unit: i.Unit, AttributeFilter: keep}, true
+	})
+
+	return redacted
+}
+
+// normalized returns the redaction with the key patterns in lower case.
+func (r Redaction) normalized() Redaction {
+	keys := make([]string, len(r.Keys))
+	for i, k := range r.Keys {
+		keys[i] = strings.ToLower(k)
+	}
+	r.Keys = keys
+	return r
+}
+
+// apply returns the attribute set with the redacted values, reporting whether it changed.
+func (r Redaction) apply(set attribute.Set) (attribute.Set, bool) {
+	kvs := set.ToSlice()
+	changed := false
+
+	for i, kv := range kvs {
+		if r.redacted(kv) {
+			kvs[i] = kv.Key.String(RedactedValue)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return set, false
+	}
+	return attribute.NewSet(kvs...), true
+}
+
+// redacted reports whether the attribute must be redacted.
+func (r Redaction) redacted(kv attribute.KeyValue) bool {
+	if kv.Value.Type() == attribute.STRING && kv.Value.AsString() == RedactedValue {
+		return false
+	}
+
+	if matchAny(r.Keys, strings.ToLower(string(kv.Key))) {
+		return true
+	}
+
+	if len(r.Values) == 0 {
+		return false
+	}
+	value := kv.Value.Emit()
+	for _, re := range r.Values {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestRedactViews verifies that every reader drops the redacted attributes, from the
+// streams of the registered views and from the other instruments, without duplicating
+// the streams of the views.
+func TestRedactViews(t *testing.T) {
+	ctx := context.Background()
+	readers := []*sdkmetric.ManualReader{sdkmetric.NewManualReader(), sdkmetric.NewManualReader()}
+
+	rename := sdkmetric.NewView(sdkmetric.Instrument{Name: "logins"}, sdkmetric.Stream{Name: "auth.logins"})
+	views := RedactViews(Redaction{Keys: []string{"*TOKEN*"}}, rename)
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(readers[0]),
+		sdkmetric.WithReader(readers[1]),
+		sdkmetric.WithView(views...),
+	)
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	meter := provider.Meter("test")
+	attrs := metric.WithAttributes(attribute.String("user", "ada"), attribute.String("api.token", "secret"))
+	for _, name := range []string{"logins", "requests"} {
+		counter, err := meter.Int64Counter(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counter.Add(ctx, 1, attrs)
+	}
+
+	for i, reader := range readers {
+		var rm metricdata.ResourceMetrics
+		if err := reader.Collect(ctx, &rm); err != nil {
+			t.Fatal(err)
+		}
+
+		var names []string
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			names = append(names, m.Name)
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				if _, ok := dp.Attributes.Value("api.token"); ok {
+					t.Errorf("reader %d: %s exposes the redacted attribute", i, m.Name)
+				}
+				if _, ok := dp.Attributes.Value("user"); !ok {
+					t.Errorf("reader %d: %s dropped the user attribute", i, m.Name)
+				}
+			}
+		}
+		if len(names) != 2 || names[0] != "auth.logins" || names[1] != "requests" {
+			t.Errorf("reader %d: got metrics %v, want [auth.logins requests]", i, names)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"os"
+	"time"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/custom/system"
+	"github.com/goxkit/metrics/noop"
+	"github.com/goxkit/metrics/options"
+)
+
+const (
+	// OTLPEnabledEnvKey is the environment variable enabling the OTLP exporter, read by
+	// the configuration package.
+	OTLPEnabledEnvKey = "OTEL_EXPORTER_OTLP_ENABLED"
+
+	// ExportIntervalEnvKey is the environment variable setting the interval, in
+	// milliseconds, of the periodic reader exporting the metrics. Default: 60000
+	ExportIntervalEnvKey = "OTEL_METRIC_EXPORT_INTERVAL"
+
+	// CardinalityLimitEnvKey is the environment variable setting the maximum number of
+	// attribute sets per instrument, the measurements of the other attribute sets are
+	// aggregated in the otel.metric.overflow set. Default: no limit
+	CardinalityLimitEnvKey = "OTEL_GO_X_CARDINALITY_LIMIT"
+)
+
+const (
+	// ExporterOTLP exports the metrics to the configured OTLP endpoint.
+	ExporterOTLP Exporter = "otlp"
+	// ExporterValidate installs the noop provider in validate mode, nothing is exported
+	// but the instrumentation bugs are reported.
+	ExporterValidate Exporter = "validate"
+)
+
+type (
+	// Exporter is the exporter selected by a Profile.
+	Exporter string
+
+	// Profile holds the default configuration of the metrics of an environment, applied
+	// by Install with options.WithEnvironmentProfile. The collectors profile is applied
+	// by passing CollectorOptions to system.BasicMetricsCollector.
+	Profile struct {
+		// Name is the profile name, such as "production".
+		Name string
+		// Exporter selects the OTLP exporter or the noop validate mode.
+		Exporter Exporter
+		// Interval is the export interval of the periodic reader.
+		Interval time.Duration
+		// CardinalityLimit is the maximum number of attribute sets per instrument,
+		// zero for no limit.
+		CardinalityLimit int
+		// Collectors is the profile of the collectors registered by system.BasicMetricsCollector.
+		Collectors system.Profile
+	}
+)
+
+var (
+	// LocalProfile validates the instrumentation without exporting, with a short
+	// interval and the minimal system collectors.
+	LocalProfile = Profile{
+		Name:       "local",
+		Exporter:   ExporterValidate,
+		Interval:   10 * time.Second,
+		Collectors: system.MinimalProfile,
+	}
+
+	// StagingProfile exports to the OTLP endpoint every 30 seconds with the full
+	// system collectors, bounding the cardinality like in production.
+	StagingProfile = Profile{
+		Name:             "staging",
+		Exporter:         ExporterOTLP,
+		Interval:         30 * time.Second,
+		CardinalityLimit: 2000,
+		Collectors:       system.FullProfile,
+	}
+
+	// ProductionProfile exports to the OTLP endpoint every minute with the full system
+	// collectors and a bounded cardinality.
+	ProductionProfile = Profile{
+		Name:             "production",
+		Exporter:         ExporterOTLP,
+		Interval:         time.Minute,
+		CardinalityLimit: 2000,
+		Collectors:       system.FullProfile,
+	}
+)
+
+// ProfileFor returns the built-in profile of the application environment: the local
+// profile for the local and development environments, the staging profile for the
+// staging and QA environments, and the production profile otherwise.
+//
+// Parameters:
+//   - env: The application environment
+//
+// Returns:
+//   - The profile of the environment
+func ProfileFor(env configs.Environment) Profile {
+	switch env {
+	case configs.LocalEnv, configs.DevelopmentEnv:
+		return LocalProfile
+	case configs.StagingEnv, configs.QaEnv:
+		return StagingProfile
+	default:
+		return ProductionProfile
+	}
+}
+
+// options fills the zero-valued fields of the configuration with the defaults of the
+// profile and returns the options applying its other defaults. The settings explicitly
+// configured in the environment variables are left to the SDK and the packages
+// reading them, the process environment is never changed.
+func (p Profile) options(cfgs *configs.Configs) []options.Option {
+	var opts []options.Option
+
+	if _, ok := os.LookupEnv(OTLPEnabledEnvKey); !ok && !cfgs.OTLPConfigs.Enabled {
+		cfgs.OTLPConfigs.Enabled = p.Exporter == ExporterOTLP
+	}
+	if _, ok := os.LookupEnv(noop.ValidateEnvKey); !ok && p.Exporter == ExporterValidate {
+		opts = append(opts, options.WithValidateMode())
+	}
+	if _, ok := os.LookupEnv(ExportIntervalEnvKey); !ok && p.Interval > 0 {
+		opts = append(opts, options.WithExportInterval(p.Interval))
+	}
+	if _, ok := os.LookupEnv(CardinalityLimitEnvKey); !ok && p.CardinalityLimit > 0 {
+		opts = append(opts, options.WithCardinalityLimit(p.CardinalityLimit))
+	}
+
+	return opts
+}
+
+// CollectorOptions returns the options of system.BasicMetricsCollector selecting the
+// collectors of the profile, none when METRICS_SYSTEM_PROFILE is set.
+//
+// Returns:
+//   - The options selecting the collectors profile
+func (p Profile) CollectorOptions() []system.Option {
+	if _, ok := os.LookupEnv(system.ProfileEnvKey); ok || p.Collectors == "" {
+		return nil
+	}
+	return []system.Option{system.WithProfile(p.Collectors)}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"os"
+	"testing"
+
+	"github.com/goxkit/configs"
+	"github.com/goxkit/metrics/options"
+)
+
+// TestProfileOptions verifies that a profile only fills the zero-valued configuration
+// fields and passes its defaults as options, without changing the process environment.
+func TestProfileOptions(t *testing.T) {
+	for _, key := range []string{OTLPEnabledEnvKey, ExportIntervalEnvKey, CardinalityLimitEnvKey} {
+		if _, ok := os.LookupEnv(key); ok {
+			t.Skipf("%s is set", key)
+		}
+	}
+
+	cfgs := &configs.Configs{OTLPConfigs: &configs.OTLPConfigs{Enabled: true}}
+	o := options.New(LocalProfile.options(cfgs)...)
+	if !cfgs.OTLPConfigs.Enabled {
+		t.Error("the local profile disabled the configured OTLP exporter")
+	}
+	if o.ExportInterval != LocalProfile.Interval {
+		t.Errorf("got export interval %v, want %v", o.ExportInterval, LocalProfile.Interval)
+	}
+
+	cfgs = &configs.Configs{OTLPConfigs: &configs.OTLPConfigs{}}
+	o = options.New(ProductionProfile.options(cfgs)...)
+	if !cfgs.OTLPConfigs.Enabled {
+		t.Error("the production profile didn't enable the OTLP exporter")
+	}
+	if o.CardinalityLimit != ProductionProfile.CardinalityLimit {
+		t.Errorf("got cardinality limit %d, want %d", o.CardinalityLimit, ProductionProfile.CardinalityLimit)
+	}
+
+	for _, key := range []string{ExportIntervalEnvKey, CardinalityLimitEnvKey} {
+		if _, ok := os.LookupEnv(key); ok {
+			t.Errorf("the profile set %s", key)
+		}
+	}
+}
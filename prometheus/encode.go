@@ -0,0 +1,209 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package prometheus
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goxkit/metrics/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// family is a Prometheus metric family, the series of all the scopes sharing a name.
+	family struct {
+		name    string
+		help    string
+		kind    string
+		samples []sample
+	}
+
+	// sample is a line of the exposition format.
+	sample struct {
+		suffix string
+		labels []label
+		value  float64
+	}
+
+	// label is a Prometheus label.
+	label struct {
+		name  string
+		value string
+	}
+)
+
+var (
+	// nameRules sanitizes the metric names.
+	nameRules = processor.NameRulesFor(processor.BackendPrometheus)
+
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	valueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// families converts the collection to Prometheus metric families, sorted by name.
+// Exponential histograms and summaries are not exposed.
+func families(rm *metricdata.ResourceMetrics) []*family {
+	byName := map[string]*family{}
+	get := func(m metricdata.Metrics, kind string) *family {
+		name := nameRules.Sanitize(m.Name)
+		if kind == "counter" && !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+		f, ok := byName[name]
+		if !ok {
+			f = &family{name: name, help: m.Description, kind: kind}
+			byName[name] = f
+		}
+		return f
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				addNumberSamples(get(m, sumKind(data.IsMonotonic)), data.DataPoints)
+			case metricdata.Sum[float64]:
+				addNumberSamples(get(m, sumKind(data.IsMonotonic)), data.DataPoints)
+			case metricdata.Gauge[int64]:
+				addNumberSamples(get(m, "gauge"), data.DataPoints)
+			case metricdata.Gauge[float64]:
+				addNumberSamples(get(m, "gauge"), data.DataPoints)
+			case metricdata.Histogram[int64]:
+				addHistogramSamples(get(m, "histogram"), data.DataPoints)
+			case metricdata.Histogram[float64]:
+				addHistogramSamples(get(m, "histogram"), data.DataPoints)
+			}
+		}
+	}
+
+	out := make([]*family, 0, len(byName))
+	for _, f := range byName {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+
+	return out
+}
+
+// sumKind returns the Prometheus type of a sum.
+func sumKind(monotonic bool) string {
+	if monotonic {
+		return "counter"
+	}
+	return "gauge"
+}
+
+// addNumberSamples adds the samples of the data points to the family.
+func addNumberSamples[N int64 | float64](f *family, dps []metricdata.DataPoint[N]) {
+	for _, dp := range dps {
+		f.samples = append(f.samples, sample{labels: labels(dp.Attributes), value: float64(dp.Value)})
+	}
+}
+
+// addHistogramSamples adds the cumulative buckets, sum and count of the histogram
+// data points to the family.
+func addHistogramSamples[N int64 | float64](f *family, dps []metricdata.HistogramDataPoint[N]) {
+	for _, dp := range dps {
+		base := labels(dp.Attributes)
+
+		var cumulative uint64
+		for i, count := range dp.BucketCounts {
+			cumulative += count
+			le := math.Inf(1)
+			if i < len(dp.Bounds) {
+				le = dp.Bounds[i]
+			}
+			f.samples = append(f.samples, sample{
+				suffix: "_bucket",
+				labels: append(base[:len(base):len(base)], label{name: "le", value: formatFloat(le)}),
+				value:  float64(cumulative),
+			})
+		}
+
+		f.samples = append(f.samples,
+			sample{suffix: "_sum", labels: base, value: float64(dp.Sum)},
+			sample{suffix: "_count", labels: base, value: float64(dp.Count)},
+		)
+	}
+}
+
+// labels converts the attributes to labels with sanitized names.
+func labels(set attribute.Set) []label {
+	out := make([]label, 0, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		out = append(out, label{name: labelName(string(kv.Key)), value: kv.Value.Emit()})
+	}
+	return out
+}
+
+// labelName converts an attribute key to a label name, [a-zA-Z_][a-zA-Z0-9_]*.
+func labelName(key string) string {
+	var b strings.Builder
+	b.Grow(len(key) + 1)
+	for i, r := range key {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// writeText writes the families in the Prometheus text exposition format 0.0.4.
+func writeText(w io.Writer, fams []*family) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range fams {
+		if len(f.samples) == 0 {
+			continue
+		}
+		if f.help != "" {
+			bw.WriteString("# HELP " + f.name + " " + helpEscaper.Replace(f.help) + "\n")
+		}
+		bw.WriteString("# TYPE " + f.name + " " + f.kind + "\n")
+
+		for _, s := range f.samples {
+			bw.WriteString(f.name + s.suffix)
+			if len(s.labels) > 0 {
+				bw.WriteByte('{')
+				for i, l := range s.labels {
+					if i > 0 {
+						bw.WriteByte(',')
+					}
+					bw.WriteString(l.name + `="` + valueEscaper.Replace(l.value) + `"`)
+				}
+				bw.WriteByte('}')
+			}
+			bw.WriteString(" " + formatFloat(s.value) + "\n")
+		}
+	}
+	return bw.Flush()
+}
+
+// formatFloat formats a sample value, with the Prometheus infinity spellings.
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
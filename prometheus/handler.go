@@ -0,0 +1,223 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package prometheus provides a pull-mode HTTP handler exposing the metrics in the
+// Prometheus text exposition format, alongside or instead of the OTLP push export.
+// Large processes can emit megabytes per scrape, so the handler compresses the
+// responses with gzip when the scraper accepts it, bounds the collection time and
+// limits the number of concurrent scrapes.
+package prometheus
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/options"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// ContentType is the content type of the text exposition format.
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	// DefaultTimeout is the default maximum duration of a scrape collection.
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultMaxConcurrentScrapes is the default number of scrapes collected concurrently.
+	DefaultMaxConcurrentScrapes = 2
+
+	// scrapeTimeoutHeader is the header in which Prometheus sends the scrape timeout.
+	scrapeTimeoutHeader = "X-Prometheus-Scrape-Timeout-Seconds"
+)
+
+type (
+	// Handler serves the metrics collected by its reader in the Prometheus text
+	// exposition format. It must be registered in the MeterProvider with the
+	// options returned by Options.
+	Handler struct {
+		reader   *sdkmetric.ManualReader
+		timeout  time.Duration
+		compress bool
+		scrapes  chan struct{}
+
+		// staleness drops the series no longer collected, disabled if nil.
+		staleness *staleness
+
+		// profileBaseURL is the pprof endpoint linked from the runtime metrics, disabled if empty.
+		profileBaseURL string
+	}
+
+	// Option configures the Handler.
+	Option func(*Handler)
+)
+
+// gzipWriters pools the gzip writers of the compressed responses.
+var gzipWriters = sync.Pool{
+	New: func() any { return gzip.NewWriter(io.Discard) },
+}
+
+// New creates a Handler.
+//
+// Parameters:
+//   - opts: Optional settings, such as the scrape timeout
+//
+// Returns:
+//   - A new Handler
+func New(opts ...Option) *Handler {
+	h := &Handler{
+		timeout:  DefaultTimeout,
+		compress: true,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	if h.staleness != nil {
+		h.reader = sdkmetric.NewManualReader(sdkmetric.WithTemporalitySelector(h.staleness.temporality))
+	} else {
+		h.reader = sdkmetric.NewManualReader()
+	}
+
+	if h.scrapes == nil {
+		h.scrapes = make(chan struct{}, DefaultMaxConcurrentScrapes)
+	}
+
+	return h
+}
+
+// WithTimeout sets the maximum duration of a scrape collection. The timeout sent by
+// Prometheus in the X-Prometheus-Scrape-Timeout-Seconds header applies when shorter.
+// Scrapes exceeding it are answered with 503 Service Unavailable.
+//
+// Parameters:
+//   - timeout: The maximum collection duration
+//
+// Returns:
+//   - An Option setting the timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Handler) {
+		if timeout > 0 {
+			h.timeout = timeout
+		}
+	}
+}
+
+// WithMaxConcurrentScrapes limits the number of scrapes collected concurrently, the
+// scrapes over the limit are answered with 429 Too Many Requests.
+//
+// Parameters:
+//   - n: The maximum number of concurrent scrapes, at least 1
+//
+// Returns:
+//   - An Option setting the limit
+func WithMaxConcurrentScrapes(n int) Option {
+	return func(h *Handler) {
+		h.scrapes = make(chan struct{}, max(n, 1))
+	}
+}
+
+// WithoutCompression disables the gzip compression of the responses.
+//
+// Returns:
+//   - An Option disabling the compression
+func WithoutCompression() Option {
+	return func(h *Handler) {
+		h.compress = false
+	}
+}
+
+// Options returns the Install options registering the handler reader.
+//
+// Returns:
+//   - The options to pass to metrics.Install
+func (h *Handler) Options() []options.Option {
+	return []options.Option{options.WithReaders(h.reader)}
+}
+
+// ServeHTTP collects the metrics and writes them in the text exposition format.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	select {
+	case h.scrapes <- struct{}{}:
+	default:
+		http.Error(w, "too many concurrent scrapes", http.StatusTooManyRequests)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.scrapeTimeout(r))
+	defer cancel()
+
+	// The collection keeps its scrape slot until it completes, even after a timeout,
+	// and the collected deltas are accumulated even if the scrape timed out
+	collected := make(chan error, 1)
+	rm := &metricdata.ResourceMetrics{}
+	go func() {
+		defer func() { <-h.scrapes }()
+		err := h.reader.Collect(ctx, rm)
+		if h.staleness != nil {
+			h.staleness.accumulate(rm, time.Now())
+		}
+		collected <- err
+	}()
+
+	select {
+	case err := <-collected:
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	case <-ctx.Done():
+		http.Error(w, "scrape timed out", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+
+	var out io.Writer = w
+	if h.compress && acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		gz := gzipWriters.Get().(*gzip.Writer)
+		gz.Reset(w)
+		defer func() {
+			_ = gz.Close()
+			gzipWriters.Put(gz)
+		}()
+		out = gz
+	}
+
+	fams := families(rm)
+	if h.profileBaseURL != "" {
+		addProfileLinks(fams, h.profileBaseURL)
+	}
+
+	_ = writeText(out, fams)
+}
+
+// scrapeTimeout returns the configured timeout, or the Prometheus scrape timeout when shorter.
+func (h *Handler) scrapeTimeout(r *http.Request) time.Duration {
+	timeout := h.timeout
+	if seconds, err := strconv.ParseFloat(r.Header.Get(scrapeTimeoutHeader), 64); err == nil && seconds > 0 {
+		timeout = min(timeout, time.Duration(seconds*float64(time.Second)))
+	}
+	return timeout
+}
+
+// acceptsGzip reports whether the request accepts a gzip encoded response.
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(enc), ";")
+		if strings.TrimSpace(name) == "gzip" && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package prometheus
+
+import (
+	"strings"
+)
+
+// profileRules maps the runtime metric names, by substring, to the profile explaining
+// them. The first matching rule applies.
+var profileRules = []struct {
+	contains string
+	profile  string
+}{
+	{"alloc", "allocs"},
+	{"malloc", "allocs"},
+	{"heap", "heap"},
+	{"gc", "heap"},
+	{"memstats", "heap"},
+	{"goroutine", "goroutine"},
+	{"thread", "threadcreate"},
+	{"cpu", "profile?seconds=30"},
+}
+
+// WithProfileLinks annotates the help of the GC, heap, goroutine, thread and CPU
+// metrics with the URL of the profile explaining them, such as
+// "(profile: http://host:6060/debug/pprof/heap)", shortening the path from "heap
+// grew" to the profile. The profiles are served by diagnostics.PprofHandler.
+//
+// Parameters:
+//   - baseURL: The URL of the pprof endpoint, such as "http://localhost:6060/debug/pprof/"
+//
+// Returns:
+//   - An Option enabling the profile links
+func WithProfileLinks(baseURL string) Option {
+	return func(h *Handler) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		h.profileBaseURL = baseURL
+	}
+}
+
+// addProfileLinks annotates the help of the families explained by a profile.
+func addProfileLinks(fams []*family, baseURL string) {
+	for _, f := range fams {
+		if profile := profileFor(f.name); profile != "" {
+			link := "(profile: " + baseURL + profile + ")"
+			if f.help == "" {
+				f.help = link
+			} else {
+				f.help += " " + link
+			}
+		}
+	}
+}
+
+// profileFor returns the profile explaining the metric, empty if none applies.
+func profileFor(name string) string {
+	if !strings.HasPrefix(name, "go_") && !strings.HasPrefix(name, "process_") && !strings.HasPrefix(name, "runtime") {
+		return ""
+	}
+
+	for _, rule := range profileRules {
+		if strings.Contains(name, rule.contains) {
+			return rule.profile
+		}
+	}
+	return ""
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package prometheus
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// staleness accumulates the delta counters and histograms collected by the handler
+	// reader, so the series not collected for staleAfter, the series no longer
+	// measured, are no longer exposed.
+	staleness struct {
+		staleAfter time.Duration
+
+		mu      sync.Mutex
+		metrics []*deltaMetric
+		index   map[metricID]*deltaMetric
+	}
+
+	// metricID identifies a metric within its scope.
+	metricID struct {
+		scope, version, name string
+	}
+
+	// deltaMetric is the cumulative state of a delta counter or histogram.
+	deltaMetric struct {
+		scope     instrumentation.Scope
+		metric    metricdata.Metrics
+		histogram bool
+		monotonic bool
+		points    []*deltaPoint
+		index     map[attribute.Distinct]*deltaPoint
+	}
+
+	// deltaPoint is the cumulative state of a series and when it was last collected.
+	deltaPoint struct {
+		attrs       attribute.Set
+		start, time time.Time
+		collected   time.Time
+
+		value  float64
+		bounds []float64
+		counts []uint64
+		sum    float64
+		count  uint64
+	}
+)
+
+// WithStaleAfter stops exposing the series not collected for d instead of freezing
+// their last values forever, for instance the counters and histograms of a code path
+// no longer executed. The handler reader then collects the synchronous counters and
+// histograms with the delta temporality, in which a series is only collected when it
+// was measured since the previous scrape, and exposes their cumulative sums. The
+// gauges, up-down counters and observable instruments are exposed while collected:
+// their series, such as the ones of the collectors disabled at runtime through the
+// admin registry, disappear as soon as they are no longer observed.
+//
+// Prometheus records a staleness marker for the series missing from a scrape, the
+// text exposition format having no explicit marker. A stale series measured again
+// restarts from zero, which Prometheus handles as a counter reset.
+//
+// Parameters:
+//   - d: The duration after which a series not collected is stale
+//
+// Returns:
+//   - An Option enabling the staleness tracking
+func WithStaleAfter(d time.Duration) Option {
+	return func(h *Handler) {
+		if d > 0 {
+			h.staleness = &staleness{staleAfter: d, index: map[metricID]*deltaMetric{}}
+		}
+	}
+}
+
+// temporality selects the delta temporality for the synchronous counters and
+// histograms, and the cumulative temporality for the other instruments.
+func (s *staleness) temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	switch kind {
+	case sdkmetric.InstrumentKindCounter, sdkmetric.InstrumentKindHistogram:
+		return metricdata.DeltaTemporality
+	default:
+		return metricdata.CumulativeTemporality
+	}
+}
+
+// accumulate adds the delta sums and histograms of the collection to their cumulative
+// state, and replaces them in rm with the cumulative series collected within
+// staleAfter of now. The series not collected for longer are forgotten. The slices of
+// rm are not modified, new ones are assigned.
+func (s *staleness) accumulate(rm *metricdata.ResourceMetrics, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scopes := make([]metricdata.ScopeMetrics, 0, len(rm.ScopeMetrics))
+	for _, sm := range rm.ScopeMetrics {
+		kept := make([]metricdata.Metrics, 0, len(sm.Metrics))
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					addDeltaSum(s.metric(sm.Scope, m, false, data.IsMonotonic), data.DataPoints, now)
+					continue
+				}
+			case metricdata.Sum[float64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					addDeltaSum(s.metric(sm.Scope, m, false, data.IsMonotonic), data.DataPoints, now)
+					continue
+				}
+			case metricdata.Histogram[int64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					addDeltaHistogram(s.metric(sm.Scope, m, true, false), data.DataPoints, now)
+					continue
+				}
+			case metricdata.Histogram[float64]:
+				if data.Temporality == metricdata.DeltaTemporality {
+					addDeltaHistogram(s.metric(sm.Scope, m, true, false), data.DataPoints, now)
+					continue
+				}
+			}
+			kept = append(kept, m)
+		}
+		scopes = append(scopes, metricdata.ScopeMetrics{Scope: sm.Scope, Metrics: kept})
+	}
+
+	// The accumulated metrics are exposed even when not collected in this scrape
+	metrics := s.metrics[:0]
+	for _, dm := range s.metrics {
+		if !dm.expire(now.Add(-s.staleAfter)) {
+			delete(s.index, metricID{dm.scope.Name, dm.scope.Version, dm.metric.Name})
+			continue
+		}
+		metrics = append(metrics, dm)
+
+		i := slices.IndexFunc(scopes, func(sm metricdata.ScopeMetrics) bool { return sm.Scope == dm.scope })
+		if i < 0 {
+			scopes = append(scopes, metricdata.ScopeMetrics{Scope: dm.scope})
+			i = len(scopes) - 1
+		}
+		scopes[i].Metrics = append(scopes[i].Metrics, dm.cumulative())
+	}
+	clear(s.metrics[len(metrics):])
+	s.metrics = metrics
+
+	rm.ScopeMetrics = scopes
+}
+
+// metric returns the cumulative state of the metric, created on its first collection.
+func (s *staleness) metric(scope instrumentation.Scope, m metricdata.Metrics, histogram, monotonic bool) *deltaMetric {
+	id := metricID{scope.Name, scope.Version, m.Name}
+	dm, ok := s.index[id]
+	if !ok {
+		dm = &deltaMetric{
+			scope:     scope,
+			metric:    metricdata.Metrics{Name: m.Name, Description: m.Description, Unit: m.Unit},
+			histogram: histogram,
+			monotonic: monotonic,
+			index:     map[attribute.Distinct]*deltaPoint{},
+		}
+		s.index[id] = dm
+		s.metrics = append(s.metrics, dm)
+	}
+	return dm
+}
+
+// point returns the cumulative state of the series, created on its first collection.
+func (dm *deltaMetric) point(attrs attribute.Set, start time.Time) *deltaPoint {
+	p, ok := dm.index[attrs.Equivalent()]
+	if !ok {
+		p = &deltaPoint{attrs: attrs, start: start}
+		dm.index[attrs.Equivalent()] = p
+		dm.points = append(dm.points, p)
+	}
+	return p
+}
+
+// expire forgets the series collected before cutoff and reports whether series remain.
+func (dm *deltaMetric) expire(cutoff time.Time) bool {
+	points := dm.points[:0]
+	for _, p := range dm.points {
+		if p.collected.Before(cutoff) {
+			delete(dm.index, p.attrs.Equivalent())
+			continue
+		}
+		points = append(points, p)
+	}
+	clear(dm.points[len(points):])
+	dm.points = points
+
+	return len(points) > 0
+}
+
+// cumulative returns the metric with the cumulative data points of its series.
+func (dm *deltaMetric) cumulative() metricdata.Metrics {
+	m := dm.metric
+	if dm.histogram {
+		dps := make([]metricdata.HistogramDataPoint[float64], 0, len(dm.points))
+		for _, p := range dm.points {
+			dps = append(dps, metricdata.HistogramDataPoint[float64]{
+				Attributes:   p.attrs,
+				StartTime:    p.start,
+				Time:         p.time,
+				Count:        p.count,
+				Bounds:       p.bounds,
+				BucketCounts: slices.Clone(p.counts),
+				Sum:          p.sum,
+			})
+		}
+		m.Data = metricdata.Histogram[float64]{DataPoints: dps, Temporality: metricdata.CumulativeTemporality}
+		return m
+	}
+
+	dps := make([]metricdata.DataPoint[float64], 0, len(dm.points))
+	for _, p := range dm.points {
+		dps = append(dps, metricdata.DataPoint[float64]{Attributes: p.attrs, StartTime: p.start, Time: p.time, Value: p.value})
+	}
+	m.Data = metricdata.Sum[float64]{DataPoints: dps, Temporality: metricdata.CumulativeTemporality, IsMonotonic: dm.monotonic}
+	return m
+}
+
+// addDeltaSum adds the delta data points to the cumulative series of the metric.
+func addDeltaSum[N int64 | float64](dm *deltaMetric, dps []metricdata.DataPoint[N], now time.Time) {
+	for _, dp := range dps {
+		p := dm.point(dp.Attributes, dp.StartTime)
+		p.value += float64(dp.Value)
+		p.time = dp.Time
+		p.collected = now
+	}
+}
+
+// addDeltaHistogram adds the delta data points to the cumulative series of the metric.
+// A series whose bounds changed restarts from the new data point.
+func addDeltaHistogram[N int64 | float64](dm *deltaMetric, dps []metricdata.HistogramDataPoint[N], now time.Time) {
+	for _, dp := range dps {
+		p := dm.point(dp.Attributes, dp.StartTime)
+		if p.counts == nil || !slices.Equal(p.bounds, dp.Bounds) {
+			p.start = dp.StartTime
+			p.bounds = slices.Clone(dp.Bounds)
+			p.counts = make([]uint64, len(dp.BucketCounts))
+			p.sum, p.count = 0, 0
+		}
+		for i, count := range dp.BucketCounts {
+			p.counts[i] += count
+		}
+		p.sum += float64(dp.Sum)
+		p.count += dp.Count
+		p.time = dp.Time
+		p.collected = now
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// scrape collects the handler reader at now and returns the exposed sample values by
+// series.
+func scrape(t *testing.T, h *Handler, now time.Time) map[string]float64 {
+	t.Helper()
+
+	rm := &metricdata.ResourceMetrics{}
+	if err := h.reader.Collect(context.Background(), rm); err != nil {
+		t.Fatal(err)
+	}
+	h.staleness.accumulate(rm, now)
+
+	values := map[string]float64{}
+	for _, f := range families(rm) {
+		for _, s := range f.samples {
+			values[f.name+s.suffix] = s.value
+		}
+	}
+	return values
+}
+
+// TestStaleAfter verifies that the series are stale when they are no longer collected,
+// and not when their value is unchanged.
+func TestStaleAfter(t *testing.T) {
+	ctx := context.Background()
+	h := New(WithStaleAfter(time.Minute))
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(h.reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	meter := provider.Meter("test")
+	idle, _ := meter.Int64Counter("idle")
+	busy, _ := meter.Int64Counter("busy")
+	latency, _ := meter.Float64Histogram("latency")
+	level, _ := meter.Int64Gauge("level")
+	observed, _ := meter.Int64ObservableCounter("observed")
+	reg, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(observed, 7)
+		return nil
+	}, observed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	idle.Add(ctx, 3)
+	level.Record(ctx, 5)
+	for i := 0; i < 3; i++ {
+		busy.Add(ctx, 2)
+		latency.Record(ctx, 0.5)
+		values := scrape(t, h, start.Add(time.Duration(i)*45*time.Second))
+
+		if got, want := values["busy_total"], float64(2*(i+1)); got != want {
+			t.Errorf("scrape %d: got busy_total %v, want %v", i, got, want)
+		}
+		if got, want := values["latency_count"], float64(i+1); got != want {
+			t.Errorf("scrape %d: got latency_count %v, want %v", i, got, want)
+		}
+		if values["level"] != 5 || values["observed_total"] != 7 {
+			t.Errorf("scrape %d: got level %v and observed_total %v, want the unchanged values exposed", i, values["level"], values["observed_total"])
+		}
+		if _, ok := values["idle_total"]; ok != (i < 2) {
+			t.Errorf("scrape %d: got idle_total exposed %v, want it stale after a minute", i, ok)
+		}
+	}
+
+	if err := reg.Unregister(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := scrape(t, h, start.Add(3*45*time.Second))["observed_total"]; ok {
+		t.Error("got observed_total exposed after its callback was unregistered")
+	}
+}
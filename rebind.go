@@ -0,0 +1,106 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// BindFunc creates the instruments and registers the callbacks of a collector with the
+// given MeterProvider.
+type BindFunc func(provider metric.MeterProvider) error
+
+// binding is a collector registered with Bind.
+type binding struct {
+	name string
+	bind BindFunc
+}
+
+var (
+	// bindingsMu guards bindings and boundProvider.
+	bindingsMu sync.Mutex
+	// bindings holds the collectors registered with Bind, in registration order.
+	bindings []binding
+	// boundProvider is the MeterProvider of the last Install, nil after Shutdown.
+	boundProvider metric.MeterProvider
+)
+
+// Bind registers a collector in the internal registry of the package, so its
+// asynchronous instruments and callback registrations are re-bound automatically
+// when the MeterProvider is rebuilt by Shutdown and Install, for instance after a
+// configuration change. Collectors don't need to be registered again by the application.
+//
+// The collector is bound immediately when a MeterProvider is installed, then on every
+// following Install. A collector registered with the same name is replaced.
+//
+// Parameters:
+//   - name: The collector name, such as "system"
+//   - bind: The function binding the collector to a MeterProvider
+//
+// Returns:
+//   - The error of the immediate binding, if any
+func Bind(name string, bind BindFunc) error {
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+
+	replaced := false
+	for i := range bindings {
+		if bindings[i].name == name {
+			bindings[i].bind = bind
+			replaced = true
+		}
+	}
+	if !replaced {
+		bindings = append(bindings, binding{name: name, bind: bind})
+	}
+
+	if boundProvider == nil {
+		return nil
+	}
+	return bind(boundProvider)
+}
+
+// Unbind removes the named collector from the registry, it is no longer bound on Install.
+// The instruments already registered with the current MeterProvider are left untouched.
+//
+// Parameters:
+//   - name: The collector name
+func Unbind(name string) {
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+
+	for i := range bindings {
+		if bindings[i].name == name {
+			bindings = append(bindings[:i], bindings[i+1:]...)
+			return
+		}
+	}
+}
+
+// rebind binds the registered collectors to the installed MeterProvider. Binding errors
+// are reported to the OpenTelemetry error handler so a collector doesn't fail the Install.
+func rebind(provider metric.MeterProvider) {
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+
+	boundProvider = provider
+	for _, b := range bindings {
+		if err := b.bind(provider); err != nil {
+			otel.Handle(fmt.Errorf("metrics: bind %s collector: %w", b.name, err))
+		}
+	}
+}
+
+// unbindProvider forgets the MeterProvider shut down, the collectors are bound again on Install.
+func unbindProvider() {
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+
+	boundProvider = nil
+}
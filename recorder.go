@@ -0,0 +1,231 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goxkit/metrics/buckets"
+	"github.com/goxkit/metrics/sanitize"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// instrumentationScope is the name of the meter used by the default Recorder.
+const instrumentationScope = "github.com/goxkit/metrics"
+
+type (
+	// Recorder is a facade over an OpenTelemetry meter that records values by
+	// instrument name. Instruments are created on first use and cached, so
+	// application code doesn't need to create and keep instruments around.
+	//
+	// A Recorder is safe for concurrent use.
+	Recorder struct {
+		meter metric.Meter
+
+		cache  InstrumentCache
+		hits   atomic.Uint64
+		misses atomic.Uint64
+
+		// shards is the number of shards of the shard attribute, disabled if zero.
+		shards int
+
+		// defaultBucketPreset and bucketPresets set the bucket layout of the histograms.
+		defaultBucketPreset buckets.Preset
+		bucketPresets       map[string]buckets.Preset
+
+		// durationUnits holds the unit of each duration histogram, by name.
+		durationUnits sync.Map
+
+		// deprecationOnce creates deprecationCounter, counting the recordings of the
+		// deprecated instruments.
+		deprecationOnce    sync.Once
+		deprecationCounter metric.Int64Counter
+	}
+
+	// RecorderOption configures a Recorder.
+	RecorderOption func(*Recorder)
+)
+
+// defaultRecorder is used by the package level recording functions.
+// It relies on the global MeterProvider, set by Install.
+var defaultRecorder = NewRecorder(otel.Meter(instrumentationScope))
+
+// NewRecorder creates a Recorder that creates its instruments with the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//   - opts: Optional settings, such as the instrument cache
+//
+// Returns:
+//   - A new Recorder
+func NewRecorder(meter metric.Meter, opts ...RecorderOption) *Recorder {
+	r := &Recorder{meter: meter}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.cache == nil {
+		r.cache = NewMapCache()
+	}
+
+	return r
+}
+
+// WithInstrumentCache sets the cache storing the instruments of the Recorder,
+// for instance NewLRUCache to bound its memory usage.
+//
+// Parameters:
+//   - cache: The instrument cache
+//
+// Returns:
+//   - A RecorderOption setting the cache
+func WithInstrumentCache(cache InstrumentCache) RecorderOption {
+	return func(r *Recorder) {
+		r.cache = cache
+	}
+}
+
+// CacheStats returns the current statistics of the instrument cache.
+func (r *Recorder) CacheStats() CacheStats {
+	return CacheStats{
+		Size:   r.cache.Len(),
+		Hits:   r.hits.Load(),
+		Misses: r.misses.Load(),
+	}
+}
+
+// DefaultRecorder returns the Recorder used by the package level functions.
+func DefaultRecorder() *Recorder {
+	return defaultRecorder
+}
+
+// Add increments the counter with the given name by value. The string attribute
+// values are sanitized with SanitizeAttrValue. Instrument creation errors are reported to the OpenTelemetry error handler.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - name: The counter name
+//   - value: The non-negative increment
+//   - attrs: The attributes of the measurement
+func (r *Recorder) Add(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) {
+	counter, err := r.counter(name)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	counter.Add(ctx, value, metric.WithAttributes(r.attributes(ctx, attrs)...))
+}
+
+// Record records value in the histogram with the given name. The string attribute
+// values are sanitized with SanitizeAttrValue. Instrument creation errors are reported to the OpenTelemetry error handler.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - name: The histogram name
+//   - value: The value to record
+//   - attrs: The attributes of the measurement
+func (r *Recorder) Record(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) {
+	histogram, err := r.histogram(name)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+
+	histogram.Record(ctx, value, metric.WithAttributes(r.attributes(ctx, attrs)...))
+}
+
+// GaugeFunc registers an observable gauge whose value is read from fn on every
+// collection, hiding the observable instrument registration mechanics.
+//
+// Parameters:
+//   - name: The gauge name
+//   - fn: The function returning the current value, it must be safe for concurrent use
+//   - attrs: The attributes attached to every observation
+//
+// Returns:
+//   - A registration that can be used to stop observing the gauge
+//   - An error if the gauge or its callback cannot be registered
+func (r *Recorder) GaugeFunc(name string, fn func() float64, attrs ...attribute.KeyValue) (metric.Registration, error) {
+	set := attribute.NewSet(sanitize.Attributes(attrs)...)
+
+	gauge, err := r.meter.Float64ObservableGauge(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		observer.ObserveFloat64(gauge, fn(), metric.WithAttributeSet(set))
+		return nil
+	}, gauge)
+}
+
+// attributes returns the sanitized attributes of a measurement, with the shard
+// attribute when enabled.
+func (r *Recorder) attributes(ctx context.Context, attrs []attribute.KeyValue) []attribute.KeyValue {
+	attrs = sanitize.Attributes(attrs)
+	if r.shards > 0 {
+		attrs = append(attrs[:len(attrs):len(attrs)], Shard(ctx, r.shards))
+	}
+	return attrs
+}
+
+// counter returns the cached counter with the given name, creating it if needed.
+func (r *Recorder) counter(name string) (metric.Float64Counter, error) {
+	return cachedInstrument(r, "counter:"+name, func() (metric.Float64Counter, error) {
+		return r.meter.Float64Counter(name)
+	})
+}
+
+// histogram returns the cached histogram with the given name, creating it if needed.
+func (r *Recorder) histogram(name string) (metric.Float64Histogram, error) {
+	return cachedInstrument(r, "histogram:"+name, func() (metric.Float64Histogram, error) {
+		return r.meter.Float64Histogram(name, r.histogramOptions(name, time.Second)...)
+	})
+}
+
+// cachedInstrument returns the instrument cached for key, creating and caching it
+// with create on a miss. Concurrent misses may create the instrument more than once,
+// which is harmless since the meter returns the same instrument. The recordings of
+// the deprecated instruments are reported.
+func cachedInstrument[T any](r *Recorder, key string, create func() (T, error)) (T, error) {
+	r.warnDeprecated(key)
+
+	if inst, ok := r.cache.Get(key); ok {
+		if t, ok := inst.(T); ok {
+			r.hits.Add(1)
+			return t, nil
+		}
+	}
+	r.misses.Add(1)
+
+	t, err := create()
+	if err != nil {
+		return t, err
+	}
+	r.cache.Set(key, t)
+
+	return t, nil
+}
+
+// Add increments the named counter using the default Recorder.
+func Add(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) {
+	defaultRecorder.Add(ctx, name, value, attrs...)
+}
+
+// Record records value in the named histogram using the default Recorder.
+func Record(ctx context.Context, name string, value float64, attrs ...attribute.KeyValue) {
+	defaultRecorder.Record(ctx, name, value, attrs...)
+}
+
+// GaugeFunc registers an observable gauge backed by fn using the default Recorder.
+func GaugeFunc(name string, fn func() float64, attrs ...attribute.KeyValue) (metric.Registration, error) {
+	return defaultRecorder.GaugeFunc(name, fn, attrs...)
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"github.com/goxkit/metrics/sanitize"
+)
+
+// SanitizeAttrValue makes a user-derived value, such as a URI or a user agent, safe
+// to record as an attribute value: invalid UTF-8 is replaced, control characters are
+// stripped and the value is truncated to sanitize.MaxValueLength bytes. The Recorder
+// and the HTTP middleware apply it automatically.
+//
+// Parameters:
+//   - s: The user-derived value
+//
+// Returns:
+//   - The sanitized value
+func SanitizeAttrValue(s string) string {
+	return sanitize.Value(s)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package sanitize makes user-derived values, such as URIs or user agents, safe to
+// record as attribute values. It is re-exported by the metrics package.
+package sanitize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MaxValueLength is the maximum length in bytes of a sanitized value.
+const MaxValueLength = 256
+
+// Value returns s as valid UTF-8 without control characters, truncated on a rune
+// boundary to MaxValueLength bytes. Invalid bytes are replaced by U+FFFD. Values
+// already safe are returned as is, without allocation.
+//
+// Parameters:
+//   - s: The user-derived value
+//
+// Returns:
+//   - The sanitized value
+func Value(s string) string {
+	if safe(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(min(len(s), MaxValueLength))
+	for _, r := range strings.ToValidUTF8(s, string(utf8.RuneError)) {
+		if unicode.IsControl(r) {
+			continue
+		}
+		if b.Len()+utf8.RuneLen(r) > MaxValueLength {
+			break
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Attributes returns attrs with their string values sanitized. The slice is only
+// copied when a value changes.
+//
+// Parameters:
+//   - attrs: The attributes to sanitize
+//
+// Returns:
+//   - The sanitized attributes
+func Attributes(attrs []attribute.KeyValue) []attribute.KeyValue {
+	sanitized, copied := attrs, false
+	for i, kv := range attrs {
+		if kv.Value.Type() != attribute.STRING {
+			continue
+		}
+
+		v := kv.Value.AsString()
+		if safe(v) {
+			continue
+		}
+
+		if !copied {
+			sanitized, copied = append([]attribute.KeyValue(nil), attrs...), true
+		}
+		sanitized[i] = kv.Key.String(Value(v))
+	}
+	return sanitized
+}
+
+// safe reports whether s is short, valid UTF-8 and free of control characters.
+func safe(s string) bool {
+	if len(s) > MaxValueLength {
+		return false
+	}
+	for _, r := range s {
+		if r == utf8.RuneError || unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
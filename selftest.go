@@ -0,0 +1,253 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// SelfTestProbe is the name of the counter incremented by SelfTest.
+const SelfTestProbe = "metrics.selftest.probe"
+
+// ErrSelfTestFailed is wrapped by the error of a failed SelfTest report.
+var ErrSelfTestFailed = errors.New("metrics: self-test failed")
+
+type (
+	// SelfTestReport is the diagnostic report of SelfTest, one step per check in
+	// execution order. The steps following a failed one are not run.
+	SelfTestReport struct {
+		Steps    []SelfTestStep
+		Duration time.Duration
+	}
+
+	// SelfTestStep is a check of SelfTest.
+	SelfTestStep struct {
+		// Name is the check name: provider, probe, flush or export.
+		Name string
+		// Detail describes what was checked, such as the exported batch size.
+		Detail   string
+		Duration time.Duration
+		Err      error
+	}
+
+	// probeWatcher captures the result of the first export carrying the probe after a
+	// SelfTest starts waiting for it.
+	probeWatcher struct {
+		mu      sync.Mutex
+		pending chan probeResult
+	}
+
+	// probeResult is the result of an export carrying the probe.
+	probeResult struct {
+		metrics int
+		err     error
+	}
+
+	// probeExporter reports the exports carrying the probe to the watcher.
+	probeExporter struct {
+		sdkmetric.Exporter
+	}
+
+	// flusher is implemented by the SDK MeterProvider.
+	flusher interface {
+		ForceFlush(ctx context.Context) error
+	}
+)
+
+// selfTestWatcher watches the exports of the installed MeterProvider for the probe.
+var selfTestWatcher probeWatcher
+
+// SelfTest checks end to end that the installed MeterProvider exports metrics: it
+// increments the SelfTestProbe counter, forces a collection and export, and verifies
+// that the export carrying the probe was accepted by the backend. For OTLP, this is the
+// result of the export RPC. It is meant for the entrypoint checks of new deployments.
+//
+// The probe counter stays in the following exports of the provider. In the noop
+// validate mode, the probe is only accepted by the validating exporter.
+//
+// Parameters:
+//   - ctx: The context bounding the collection and export
+//
+// Returns:
+//   - The diagnostic report, whose Err method returns the failure, if any
+func SelfTest(ctx context.Context) *SelfTestReport {
+	start := time.Now()
+	report := &SelfTestReport{}
+	defer func() { report.Duration = time.Since(start) }()
+
+	bindingsMu.Lock()
+	provider := boundProvider
+	bindingsMu.Unlock()
+
+	var (
+		flushable flusher
+		counter   metric.Int64Counter
+		pending   chan probeResult
+	)
+
+	steps := []struct {
+		name string
+		run  func() (string, error)
+	}{
+		{"provider", func() (string, error) {
+			if provider == nil {
+				return "", errors.New("no MeterProvider installed, call Install first")
+			}
+			f, ok := provider.(flusher)
+			if !ok {
+				return "", fmt.Errorf("%T cannot force an export", provider)
+			}
+			flushable = f
+			return fmt.Sprintf("%T", provider), nil
+		}},
+		{"probe", func() (string, error) {
+			var err error
+			counter, err = provider.Meter(instrumentationScope).Int64Counter(SelfTestProbe, metric.WithDescription("Number of metrics self-tests run."), metric.WithUnit("{test}"))
+			if err != nil {
+				return "", err
+			}
+			pending = selfTestWatcher.watch()
+			counter.Add(ctx, 1)
+			return SelfTestProbe + " incremented", nil
+		}},
+		{"flush", func() (string, error) {
+			return "collection and export forced", flushable.ForceFlush(ctx)
+		}},
+		{"export", func() (string, error) {
+			select {
+			case result := <-pending:
+				if result.err != nil {
+					return "", fmt.Errorf("backend rejected the export: %w", result.err)
+				}
+				return fmt.Sprintf("probe accepted in an export of %d metrics", result.metrics), nil
+			default:
+				return "", errors.New("probe not exported, the provider has no exporting reader")
+			}
+		}},
+	}
+
+	defer selfTestWatcher.stop()
+	for _, step := range steps {
+		stepStart := time.Now()
+		detail, err := step.run()
+		report.Steps = append(report.Steps, SelfTestStep{Name: step.name, Detail: detail, Duration: time.Since(stepStart), Err: err})
+		if err != nil {
+			break
+		}
+	}
+
+	return report
+}
+
+// Err returns the error of the failed step wrapping ErrSelfTestFailed, or nil if the
+// self-test passed.
+func (r *SelfTestReport) Err() error {
+	for _, step := range r.Steps {
+		if step.Err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrSelfTestFailed, step.Name, step.Err)
+		}
+	}
+	return nil
+}
+
+// String formats the report, one line per step.
+func (r *SelfTestReport) String() string {
+	var b strings.Builder
+
+	for _, step := range r.Steps {
+		status, detail := "ok", step.Detail
+		if step.Err != nil {
+			status, detail = "FAILED", step.Err.Error()
+		}
+		fmt.Fprintf(&b, "%-8s %-6s %-10s %s\n", step.Name, status, step.Duration.Round(time.Microsecond), detail)
+	}
+
+	result := "passed"
+	if r.Err() != nil {
+		result = "failed"
+	}
+	fmt.Fprintf(&b, "self-test %s in %s\n", result, r.Duration.Round(time.Microsecond))
+
+	return b.String()
+}
+
+// watchProbeExports wraps the exporter of the installed MeterProvider so SelfTest sees
+// the result of the export carrying the probe.
+func watchProbeExports(exp sdkmetric.Exporter) sdkmetric.Exporter {
+	return &probeExporter{Exporter: exp}
+}
+
+// Export exports rm and reports the result to the watcher if rm carries the probe.
+func (e *probeExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	err := e.Exporter.Export(ctx, rm)
+
+	if selfTestWatcher.watching() {
+		if n, ok := probeMetrics(rm); ok {
+			selfTestWatcher.report(probeResult{metrics: n, err: err})
+		}
+	}
+
+	return err
+}
+
+// watch starts waiting for an export carrying the probe.
+func (w *probeWatcher) watch() chan probeResult {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending = make(chan probeResult, 1)
+	return w.pending
+}
+
+// stop stops waiting for an export carrying the probe.
+func (w *probeWatcher) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending = nil
+}
+
+// watching reports whether a SelfTest waits for an export carrying the probe.
+func (w *probeWatcher) watching() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.pending != nil
+}
+
+// report delivers the result of the first export carrying the probe.
+func (w *probeWatcher) report(result probeResult) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.pending == nil {
+		return
+	}
+	w.pending <- result
+	w.pending = nil
+}
+
+// probeMetrics returns the number of metrics of rm, and whether rm carries the probe.
+func probeMetrics(rm *metricdata.ResourceMetrics) (int, bool) {
+	n, found := 0, false
+	for _, sm := range rm.ScopeMetrics {
+		n += len(sm.Metrics)
+		for _, m := range sm.Metrics {
+			if m.Name == SelfTestProbe {
+				found = true
+			}
+		}
+	}
+	return n, found
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"hash/fnv"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ShardKey is the key of the shard attribute.
+const ShardKey = attribute.Key("shard")
+
+var (
+	instanceHash     uint64
+	instanceHashOnce sync.Once
+)
+
+// Shard returns the shard attribute, a stable hash bucket of the trace of ctx or, for
+// measurements recorded outside of a trace, of the instance. Splitting the traffic by
+// shard lets teams compare two exporter or aggregation configurations side by side in
+// production, for instance by dropping a different shard in each pipeline.
+//
+// Parameters:
+//   - ctx: The context of the measurement
+//   - buckets: The number of shards, at least 1
+//
+// Returns:
+//   - The shard attribute, with a value in [0, buckets)
+func Shard(ctx context.Context, buckets int) attribute.KeyValue {
+	if buckets < 1 {
+		buckets = 1
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); sc.TraceID().IsValid() {
+		id := sc.TraceID()
+		return ShardKey.Int(int(hash(id[:]) % uint64(buckets)))
+	}
+
+	return ShardKey.Int(InstanceShard(buckets))
+}
+
+// InstanceShard returns the stable hash bucket of the instance, identified by
+// OTEL_SERVICE_INSTANCE_ID or the host name.
+//
+// Parameters:
+//   - buckets: The number of shards, at least 1
+//
+// Returns:
+//   - The instance shard, in [0, buckets)
+func InstanceShard(buckets int) int {
+	if buckets < 1 {
+		buckets = 1
+	}
+
+	instanceHashOnce.Do(func() {
+		id := os.Getenv("OTEL_SERVICE_INSTANCE_ID")
+		if id == "" {
+			id, _ = os.Hostname()
+		}
+		instanceHash = hash([]byte(id))
+	})
+
+	return int(instanceHash % uint64(buckets))
+}
+
+// WithShardAttribute adds the shard attribute, computed with Shard, to the counters
+// and histograms recorded by the Recorder.
+//
+// Parameters:
+//   - buckets: The number of shards, at least 1
+//
+// Returns:
+//   - A RecorderOption adding the shard attribute
+func WithShardAttribute(buckets int) RecorderOption {
+	return func(r *Recorder) {
+		r.shards = max(buckets, 1)
+	}
+}
+
+// hash returns the FNV-1a hash of b.
+func hash(b []byte) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(b)
+	return h.Sum64()
+}
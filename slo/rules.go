@@ -0,0 +1,199 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package slo
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BurnRateAlert is a multi-window burn rate alert: it fires when the error budget
+// burns Factor times faster than allowed over both the long and the short window,
+// the short one making the alert reset quickly after recovery. Factor is relative
+// to a 30 days window and scaled to the window of the objective.
+type BurnRateAlert struct {
+	Long     time.Duration
+	Short    time.Duration
+	Factor   float64
+	Severity string
+}
+
+// DefaultBurnRateAlerts are the multi-window burn rate alerts of the Google SRE
+// workbook for a 30 days window: pages when 2% of the budget burns in 1h or 5% in
+// 6h, tickets when 10% burns in 3 days.
+var DefaultBurnRateAlerts = []BurnRateAlert{
+	{Long: time.Hour, Short: 5 * time.Minute, Factor: 14.4, Severity: "page"},
+	{Long: 6 * time.Hour, Short: 30 * time.Minute, Factor: 6, Severity: "page"},
+	{Long: 24 * time.Hour, Short: 2 * time.Hour, Factor: 3, Severity: "ticket"},
+	{Long: 3 * 24 * time.Hour, Short: 6 * time.Hour, Factor: 1, Severity: "ticket"},
+}
+
+// WriteRules writes a Prometheus rule file with, per objective, the recording
+// rules of the error ratio over every alert window and the burn rate alerts.
+//
+// Parameters:
+//   - w: The writer receiving the YAML document
+//   - alerts: The burn rate alerts, DefaultBurnRateAlerts if empty
+//   - objectives: The objectives
+//
+// Returns:
+//   - An error matching ErrInvalidObjective for an invalid objective, or the write error
+func WriteRules(w io.Writer, alerts []BurnRateAlert, objectives ...Objective) error {
+	groups, err := ruleGroups(alerts, objectives)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "groups:\n"+groups)
+	return err
+}
+
+// WritePrometheusRule writes the rules of WriteRules wrapped in a PrometheusRule
+// resource of the Prometheus Operator.
+//
+// Parameters:
+//   - w: The writer receiving the YAML document
+//   - name: The resource name
+//   - namespace: The resource namespace, omitted if empty
+//   - alerts: The burn rate alerts, DefaultBurnRateAlerts if empty
+//   - objectives: The objectives
+//
+// Returns:
+//   - An error matching ErrInvalidObjective for an invalid objective, or the write error
+func WritePrometheusRule(w io.Writer, name, namespace string, alerts []BurnRateAlert, objectives ...Objective) error {
+	groups, err := ruleGroups(alerts, objectives)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("apiVersion: monitoring.coreos.com/v1\nkind: PrometheusRule\nmetadata:\n")
+	fmt.Fprintf(&b, "  name: %s\n", quote(name))
+	if namespace != "" {
+		fmt.Fprintf(&b, "  namespace: %s\n", quote(namespace))
+	}
+	b.WriteString("spec:\n  groups:\n")
+	for _, line := range strings.SplitAfter(groups, "\n") {
+		if line != "" {
+			b.WriteString("  " + line)
+		}
+	}
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// ruleGroups returns the YAML list of the rule groups, one per objective.
+func ruleGroups(alerts []BurnRateAlert, objectives []Objective) (string, error) {
+	if len(alerts) == 0 {
+		alerts = DefaultBurnRateAlerts
+	}
+
+	var b strings.Builder
+	for _, o := range objectives {
+		if err := o.Validate(); err != nil {
+			return "", err
+		}
+		writeGroup(&b, o, alerts)
+	}
+
+	return b.String(), nil
+}
+
+// writeGroup writes the rule group of an objective.
+func writeGroup(b *strings.Builder, o Objective, alerts []BurnRateAlert) {
+	fmt.Fprintf(b, "- name: %s\n  rules:\n", quote("slo-"+o.Name))
+
+	// One recording rule per distinct window
+	for _, window := range windows(alerts) {
+		fmt.Fprintf(b, "  - record: %s\n", errorRatioName(o, window))
+		fmt.Fprintf(b, "    expr: %s\n", quote(fmt.Sprintf("sum(rate(%s[%s])) / sum(rate(%s[%s]))", o.Errors, promDuration(window), o.Total, promDuration(window))))
+		fmt.Fprintf(b, "    labels:\n      slo: %s\n", quote(o.Name))
+	}
+
+	for _, a := range alerts {
+		factor := a.Factor * float64(o.window()) / float64(DefaultWindow)
+		threshold := strconv.FormatFloat(factor*o.ErrorBudget(), 'g', -1, 64)
+		expr := fmt.Sprintf("%s > %s and %s > %s", errorRatioName(o, a.Long), threshold, errorRatioName(o, a.Short), threshold)
+
+		fmt.Fprintf(b, "  - alert: %s\n", quote(alertName(o, a)))
+		fmt.Fprintf(b, "    expr: %s\n", quote(expr))
+		b.WriteString("    labels:\n")
+		for _, kv := range sortedLabels(o, a) {
+			fmt.Fprintf(b, "      %s: %s\n", kv[0], quote(kv[1]))
+		}
+		b.WriteString("    annotations:\n")
+		fmt.Fprintf(b, "      summary: %s\n", quote(fmt.Sprintf("%s is burning its error budget %gx too fast over %s", o.Name, factor, promDuration(a.Long))))
+		if o.Description != "" {
+			fmt.Fprintf(b, "      description: %s\n", quote(o.Description))
+		}
+	}
+}
+
+// windows returns the distinct alert windows, sorted.
+func windows(alerts []BurnRateAlert) []time.Duration {
+	seen := map[time.Duration]bool{}
+	var ws []time.Duration
+	for _, a := range alerts {
+		for _, w := range []time.Duration{a.Long, a.Short} {
+			if !seen[w] {
+				seen[w] = true
+				ws = append(ws, w)
+			}
+		}
+	}
+
+	sort.Slice(ws, func(i, j int) bool { return ws[i] < ws[j] })
+	return ws
+}
+
+// sortedLabels returns the alert labels sorted by name, the objective labels
+// taking precedence over the generated ones.
+func sortedLabels(o Objective, a BurnRateAlert) [][2]string {
+	labels := map[string]string{"slo": o.Name, "severity": a.Severity}
+	for k, v := range o.Labels {
+		labels[k] = v
+	}
+
+	kvs := make([][2]string, 0, len(labels))
+	for k, v := range labels {
+		kvs = append(kvs, [2]string{k, v})
+	}
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i][0] < kvs[j][0] })
+	return kvs
+}
+
+// errorRatioName returns the recording rule name of the error ratio over window.
+func errorRatioName(o Objective, window time.Duration) string {
+	return fmt.Sprintf("slo:%s:error_ratio_rate%s", o.Name, promDuration(window))
+}
+
+// alertName returns the name of a burn rate alert.
+func alertName(o Objective, a BurnRateAlert) string {
+	return fmt.Sprintf("SLOBurnRate_%s_%s", o.Name, promDuration(a.Long))
+}
+
+// promDuration formats d as a Prometheus duration, such as 5m, 6h or 3d.
+func promDuration(d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return strconv.FormatInt(int64(d/(24*time.Hour)), 10) + "d"
+	case d%time.Hour == 0:
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	case d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	default:
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+}
+
+// quote returns s as a YAML double-quoted scalar.
+func quote(s string) string {
+	return strconv.Quote(s)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package slo defines service level objectives in code and generates the matching
+// Prometheus alerting rules, so the objectives and the alerting configuration
+// can't drift apart.
+package slo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// DefaultWindow is the compliance window of an objective without Window.
+const DefaultWindow = 30 * 24 * time.Hour
+
+// ErrInvalidObjective is returned when an objective is missing required settings.
+var ErrInvalidObjective = errors.New("invalid objective")
+
+// objectiveName restricts the objective names to valid Prometheus rule name parts.
+var objectiveName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Objective is a service level objective: the ratio of good events over all events
+// must stay above Target over Window. Events are counted by Prometheus counters.
+type Objective struct {
+	// Name identifies the objective, such as orders_api_availability.
+	Name string
+	// Description explains the objective in the alert annotations.
+	Description string
+	// Target is the objective, such as 0.999 for 99.9%.
+	Target float64
+	// Window is the compliance window. Default: 30 days.
+	Window time.Duration
+	// Total is the PromQL selector of the counter of all events,
+	// such as http_requests_total{service="orders"}.
+	Total string
+	// Errors is the PromQL selector of the counter of bad events,
+	// such as http_requests_total{service="orders",statusCode=~"5.."}.
+	Errors string
+	// Labels are added to the generated alerts, such as team or severity routing.
+	Labels map[string]string
+}
+
+// Validate checks the objective settings.
+//
+// Returns:
+//   - An error matching ErrInvalidObjective if a setting is missing or out of range
+func (o Objective) Validate() error {
+	switch {
+	case !objectiveName.MatchString(o.Name):
+		return fmt.Errorf("%w: name %q must match %s", ErrInvalidObjective, o.Name, objectiveName)
+	case o.Target <= 0 || o.Target >= 1:
+		return fmt.Errorf("%w: %s: target must be between 0 and 1 exclusive", ErrInvalidObjective, o.Name)
+	case o.Total == "" || o.Errors == "":
+		return fmt.Errorf("%w: %s: total and errors selectors are required", ErrInvalidObjective, o.Name)
+	case o.Window < 0:
+		return fmt.Errorf("%w: %s: negative window", ErrInvalidObjective, o.Name)
+	}
+
+	return nil
+}
+
+// ErrorBudget returns the allowed ratio of bad events, 1 - Target.
+//
+// Returns:
+//   - The error budget ratio
+func (o Objective) ErrorBudget() float64 {
+	return 1 - o.Target
+}
+
+// window returns the compliance window, DefaultWindow if unset.
+func (o Objective) window() time.Duration {
+	if o.Window <= 0 {
+		return DefaultWindow
+	}
+	return o.Window
+}
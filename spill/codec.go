@@ -0,0 +1,266 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package spill
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goxkit/metrics/internal/attrcodec"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	kindSum       = "sum"
+	kindGauge     = "gauge"
+	kindHistogram = "histogram"
+)
+
+type (
+	// batch is the serialized form of a ResourceMetrics.
+	batch struct {
+		SpilledAt time.Time            `json:"spilledAt"`
+		SchemaURL string               `json:"schemaUrl,omitempty"`
+		Resource  []attrcodec.KeyValue `json:"resource"`
+		Scopes    []scopeRecord        `json:"scopes"`
+	}
+
+	// scopeRecord is the serialized form of a ScopeMetrics.
+	scopeRecord struct {
+		Name      string         `json:"name"`
+		Version   string         `json:"version,omitempty"`
+		SchemaURL string         `json:"schemaUrl,omitempty"`
+		Metrics   []metricRecord `json:"metrics"`
+	}
+
+	// metricRecord is the serialized form of a Metrics.
+	metricRecord struct {
+		Name        string      `json:"name"`
+		Description string      `json:"description,omitempty"`
+		Unit        string      `json:"unit,omitempty"`
+		Kind        string      `json:"kind"`
+		Temporality temporality `json:"temporality,omitempty"`
+		IsMonotonic bool        `json:"isMonotonic,omitempty"`
+
+		Int64Points       []numberPoint[int64]      `json:"int64Points,omitempty"`
+		Float64Points     []numberPoint[float64]    `json:"float64Points,omitempty"`
+		Int64Histograms   []histogramPoint[int64]   `json:"int64Histograms,omitempty"`
+		Float64Histograms []histogramPoint[float64] `json:"float64Histograms,omitempty"`
+	}
+
+	// temporality is the serialized form of a Temporality, its name.
+	temporality metricdata.Temporality
+
+	// numberPoint is the serialized form of a DataPoint.
+	numberPoint[N int64 | float64] struct {
+		Attributes []attrcodec.KeyValue `json:"attributes,omitempty"`
+		StartTime  time.Time            `json:"startTime"`
+		Time       time.Time            `json:"time"`
+		Value      N                    `json:"value"`
+	}
+
+	// histogramPoint is the serialized form of a HistogramDataPoint.
+	histogramPoint[N int64 | float64] struct {
+		Attributes   []attrcodec.KeyValue `json:"attributes,omitempty"`
+		StartTime    time.Time            `json:"startTime"`
+		Time         time.Time            `json:"time"`
+		Count        uint64               `json:"count"`
+		Bounds       []float64            `json:"bounds"`
+		BucketCounts []uint64             `json:"bucketCounts"`
+		Min          *N                   `json:"min,omitempty"`
+		Max          *N                   `json:"max,omitempty"`
+		Sum          N                    `json:"sum"`
+	}
+)
+
+// encodeBatch converts rm to its serialized form, skipping the aggregations that
+// can't be spilled.
+func encodeBatch(rm *metricdata.ResourceMetrics, spilledAt time.Time) batch {
+	b := batch{SpilledAt: spilledAt}
+	if rm.Resource != nil {
+		b.SchemaURL = rm.Resource.SchemaURL()
+		b.Resource = attrcodec.Encode(*rm.Resource.Set())
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		scope := scopeRecord{Name: sm.Scope.Name, Version: sm.Scope.Version, SchemaURL: sm.Scope.SchemaURL}
+		for _, m := range sm.Metrics {
+			if record, ok := encodeMetric(m); ok {
+				scope.Metrics = append(scope.Metrics, record)
+			}
+		}
+		if len(scope.Metrics) > 0 {
+			b.Scopes = append(b.Scopes, scope)
+		}
+	}
+
+	return b
+}
+
+// encodeMetric converts m to its serialized form.
+func encodeMetric(m metricdata.Metrics) (metricRecord, bool) {
+	r := metricRecord{Name: m.Name, Description: m.Description, Unit: m.Unit}
+
+	switch data := m.Data.(type) {
+	case metricdata.Sum[int64]:
+		r.Kind, r.Temporality, r.IsMonotonic = kindSum, temporality(data.Temporality), data.IsMonotonic
+		r.Int64Points = encodeNumberPoints(data.DataPoints)
+	case metricdata.Sum[float64]:
+		r.Kind, r.Temporality, r.IsMonotonic = kindSum, temporality(data.Temporality), data.IsMonotonic
+		r.Float64Points = encodeNumberPoints(data.DataPoints)
+	case metricdata.Gauge[int64]:
+		r.Kind = kindGauge
+		r.Int64Points = encodeNumberPoints(data.DataPoints)
+	case metricdata.Gauge[float64]:
+		r.Kind = kindGauge
+		r.Float64Points = encodeNumberPoints(data.DataPoints)
+	case metricdata.Histogram[int64]:
+		r.Kind, r.Temporality = kindHistogram, temporality(data.Temporality)
+		r.Int64Histograms = encodeHistogramPoints(data.DataPoints)
+	case metricdata.Histogram[float64]:
+		r.Kind, r.Temporality = kindHistogram, temporality(data.Temporality)
+		r.Float64Histograms = encodeHistogramPoints(data.DataPoints)
+	default:
+		return r, false
+	}
+
+	return r, true
+}
+
+// encodeNumberPoints converts the data points to their serialized form.
+func encodeNumberPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []numberPoint[N] {
+	points := make([]numberPoint[N], 0, len(dps))
+	for _, dp := range dps {
+		points = append(points, numberPoint[N]{
+			Attributes: attrcodec.Encode(dp.Attributes),
+			StartTime:  dp.StartTime,
+			Time:       dp.Time,
+			Value:      dp.Value,
+		})
+	}
+	return points
+}
+
+// encodeHistogramPoints converts the histogram data points to their serialized form.
+func encodeHistogramPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []histogramPoint[N] {
+	points := make([]histogramPoint[N], 0, len(dps))
+	for _, dp := range dps {
+		p := histogramPoint[N]{
+			Attributes:   attrcodec.Encode(dp.Attributes),
+			StartTime:    dp.StartTime,
+			Time:         dp.Time,
+			Count:        dp.Count,
+			Bounds:       append([]float64(nil), dp.Bounds...),
+			BucketCounts: append([]uint64(nil), dp.BucketCounts...),
+			Sum:          dp.Sum,
+		}
+		if v, ok := dp.Min.Value(); ok {
+			p.Min = &v
+		}
+		if v, ok := dp.Max.Value(); ok {
+			p.Max = &v
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// MarshalText returns the name of the temporality.
+func (t temporality) MarshalText() ([]byte, error) {
+	return metricdata.Temporality(t).MarshalText()
+}
+
+// UnmarshalText parses the name of the temporality.
+func (t *temporality) UnmarshalText(text []byte) error {
+	for _, candidate := range []metricdata.Temporality{metricdata.CumulativeTemporality, metricdata.DeltaTemporality} {
+		if candidate.String() == string(text) {
+			*t = temporality(candidate)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown temporality %q", text)
+}
+
+// decode converts the serialized batch back to a ResourceMetrics.
+func (b batch) decode() *metricdata.ResourceMetrics {
+	rm := &metricdata.ResourceMetrics{
+		Resource: resource.NewWithAttributes(b.SchemaURL, attrcodec.Decode(b.Resource)...),
+	}
+
+	for _, scope := range b.Scopes {
+		sm := metricdata.ScopeMetrics{
+			Scope: instrumentation.Scope{Name: scope.Name, Version: scope.Version, SchemaURL: scope.SchemaURL},
+		}
+		for _, r := range scope.Metrics {
+			sm.Metrics = append(sm.Metrics, r.decode())
+		}
+		rm.ScopeMetrics = append(rm.ScopeMetrics, sm)
+	}
+
+	return rm
+}
+
+// decode converts the serialized metric back to a Metrics.
+func (r metricRecord) decode() metricdata.Metrics {
+	m := metricdata.Metrics{Name: r.Name, Description: r.Description, Unit: r.Unit}
+
+	switch {
+	case r.Kind == kindSum && r.Int64Points != nil:
+		m.Data = metricdata.Sum[int64]{DataPoints: decodeNumberPoints(r.Int64Points), Temporality: metricdata.Temporality(r.Temporality), IsMonotonic: r.IsMonotonic}
+	case r.Kind == kindSum:
+		m.Data = metricdata.Sum[float64]{DataPoints: decodeNumberPoints(r.Float64Points), Temporality: metricdata.Temporality(r.Temporality), IsMonotonic: r.IsMonotonic}
+	case r.Kind == kindGauge && r.Int64Points != nil:
+		m.Data = metricdata.Gauge[int64]{DataPoints: decodeNumberPoints(r.Int64Points)}
+	case r.Kind == kindGauge:
+		m.Data = metricdata.Gauge[float64]{DataPoints: decodeNumberPoints(r.Float64Points)}
+	case r.Kind == kindHistogram && r.Int64Histograms != nil:
+		m.Data = metricdata.Histogram[int64]{DataPoints: decodeHistogramPoints(r.Int64Histograms), Temporality: metricdata.Temporality(r.Temporality)}
+	default:
+		m.Data = metricdata.Histogram[float64]{DataPoints: decodeHistogramPoints(r.Float64Histograms), Temporality: metricdata.Temporality(r.Temporality)}
+	}
+
+	return m
+}
+
+// decodeNumberPoints converts the serialized data points back.
+func decodeNumberPoints[N int64 | float64](points []numberPoint[N]) []metricdata.DataPoint[N] {
+	dps := make([]metricdata.DataPoint[N], 0, len(points))
+	for _, p := range points {
+		dps = append(dps, metricdata.DataPoint[N]{
+			Attributes: attribute.NewSet(attrcodec.Decode(p.Attributes)...),
+			StartTime:  p.StartTime,
+			Time:       p.Time,
+			Value:      p.Value,
+		})
+	}
+	return dps
+}
+
+// decodeHistogramPoints converts the serialized histogram data points back.
+func decodeHistogramPoints[N int64 | float64](points []histogramPoint[N]) []metricdata.HistogramDataPoint[N] {
+	dps := make([]metricdata.HistogramDataPoint[N], 0, len(points))
+	for _, p := range points {
+		dp := metricdata.HistogramDataPoint[N]{
+			Attributes:   attribute.NewSet(attrcodec.Decode(p.Attributes)...),
+			StartTime:    p.StartTime,
+			Time:         p.Time,
+			Count:        p.Count,
+			Bounds:       p.Bounds,
+			BucketCounts: p.BucketCounts,
+			Sum:          p.Sum,
+		}
+		if p.Min != nil {
+			dp.Min = metricdata.NewExtrema(*p.Min)
+		}
+		if p.Max != nil {
+			dp.Max = metricdata.NewExtrema(*p.Max)
+		}
+		dps = append(dps, dp)
+	}
+	return dps
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package spill provides a bounded on-disk buffer for the export batches failing
+// while the collector is down. The spilled batches are replayed, oldest first and one
+// per successful export, once the exports succeed again and expire after a maximum
+// age, so short collector outages don't cause gaps in business-critical counters.
+package spill
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/options"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// DefaultMaxBytes is the default maximum size of the spilled batches on disk.
+	DefaultMaxBytes = 64 << 20
+	// DefaultMaxAge is the default age after which a spilled batch is discarded.
+	DefaultMaxAge = time.Hour
+
+	// fileExt is the extension of the spilled batch files.
+	fileExt = ".json"
+)
+
+// ErrInvalidConfig is returned by New when the buffer directory is not set.
+var ErrInvalidConfig = errors.New("invalid spill buffer config")
+
+type (
+	// Config holds the settings of the spill Buffer.
+	Config struct {
+		// Dir is the directory storing the spilled batches, created if needed.
+		Dir string
+		// MaxBytes bounds the size of the spilled batches, the oldest batches are
+		// discarded first. Default: DefaultMaxBytes
+		MaxBytes int64
+		// MaxAge is the age after which a spilled batch is discarded instead of
+		// replayed. Default: DefaultMaxAge
+		MaxAge time.Duration
+	}
+
+	// Buffer spills the failed export batches to disk and replays them once the
+	// exporter recovers. It must be registered with the options returned by Options.
+	//
+	// Exemplars are not spilled, neither are the exponential histograms and the
+	// summaries.
+	Buffer struct {
+		cfg Config
+
+		// mu serializes the accesses to the spill directory, it is never held
+		// while exporting.
+		mu sync.Mutex
+	}
+
+	// exporter spills the batches the wrapped exporter fails to export.
+	exporter struct {
+		sdkmetric.Exporter
+		buffer *Buffer
+
+		// mu serializes the live and replayed exports of the wrapped exporter.
+		mu sync.Mutex
+	}
+
+	// spillFile describes a batch file of the spill directory.
+	spillFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+)
+
+// New creates a Buffer storing the spilled batches in cfg.Dir.
+//
+// Parameters:
+//   - cfg: The buffer settings
+//
+// Returns:
+//   - A new Buffer
+//   - An error if the directory is not set or cannot be created
+func New(cfg Config) (*Buffer, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("%w: empty directory", ErrInvalidConfig)
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultMaxBytes
+	}
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = DefaultMaxAge
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &Buffer{cfg: cfg}, nil
+}
+
+// Options returns the Install options wrapping the exporter with the buffer.
+//
+// Returns:
+//   - The options to pass to metrics.Install
+func (b *Buffer) Options() []options.Option {
+	return []options.Option{
+		options.WithExporterWrapper(b.Wrap),
+	}
+}
+
+// Wrap wraps exp so its failed export batches are spilled to the buffer.
+//
+// Parameters:
+//   - exp: The exporter to wrap
+//
+// Returns:
+//   - The wrapping exporter
+func (b *Buffer) Wrap(exp sdkmetric.Exporter) sdkmetric.Exporter {
+	return &exporter{Exporter: exp, buffer: b}
+}
+
+// Export exports rm, spilling it to disk on failure. After a successful export, the
+// oldest spilled batch is replayed with the same context, so the wrapped exporter is
+// never called concurrently and the backlog drains one batch per export interval.
+// The replay errors are reported to the OpenTelemetry error handler, the batch is
+// then replayed after the next successful export.
+func (e *exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err := e.Exporter.Export(ctx, rm); err != nil {
+		// The batch is reused by the reader once Export returns, spill it now
+		if serr := e.buffer.spill(rm); serr != nil {
+			return errors.Join(err, fmt.Errorf("failed to spill metrics batch: %w", serr))
+		}
+		return err
+	}
+
+	if err := e.buffer.replay(ctx, e.Exporter); err != nil {
+		otel.Handle(err)
+	}
+
+	return nil
+}
+
+// spill writes rm to a new batch file and enforces the size and age bounds.
+func (b *Buffer) spill(rm *metricdata.ResourceMetrics) error {
+	data, err := json.Marshal(encodeBatch(rm, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := filepath.Join(b.cfg.Dir, fmt.Sprintf("%020d%s", time.Now().UnixNano(), fileExt))
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		return err
+	}
+
+	return b.enforceLimits()
+}
+
+// replay exports the oldest spilled batch, if any, and removes it once exported. The
+// expired and corrupted batches are removed without being exported. The spill
+// directory isn't locked during the export.
+func (b *Buffer) replay(ctx context.Context, exp sdkmetric.Exporter) error {
+	path, spilled, err := b.oldest()
+	if err != nil || path == "" {
+		return err
+	}
+
+	if err := exp.Export(ctx, spilled.decode()); err != nil {
+		return fmt.Errorf("failed to replay spilled metrics batch: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// The batch may have been discarded by the size bound meanwhile
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// oldest returns the path and the content of the oldest spilled batch still to be
+// replayed, or an empty path if there is none.
+func (b *Buffer) oldest() (string, *batch, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	files, err := b.files()
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, f := range files {
+		data, err := os.ReadFile(f.path)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var spilled batch
+		if err := json.Unmarshal(data, &spilled); err != nil || time.Since(spilled.SpilledAt) > b.cfg.MaxAge {
+			// A corrupted or expired batch is never replayed
+			if err := os.Remove(f.path); err != nil {
+				return "", nil, err
+			}
+			continue
+		}
+
+		return f.path, &spilled, nil
+	}
+
+	return "", nil, nil
+}
+
+// enforceLimits discards the expired batches and the oldest batches exceeding MaxBytes.
+func (b *Buffer) enforceLimits() error {
+	files, err := b.files()
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+
+	for _, f := range files {
+		if total <= b.cfg.MaxBytes && time.Since(f.modTime) <= b.cfg.MaxAge {
+			break
+		}
+		if err := os.Remove(f.path); err != nil {
+			return err
+		}
+		total -= f.size
+	}
+
+	return nil
+}
+
+// files lists the batch files of the spill directory, oldest first.
+func (b *Buffer) files() ([]spillFile, error) {
+	entries, err := os.ReadDir(b.cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]spillFile, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), fileExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, spillFile{path: filepath.Join(b.cfg.Dir, e.Name()), size: info.Size(), modTime: info.ModTime()})
+	}
+
+	// The file names are zero-padded timestamps
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+
+	return files, nil
+}
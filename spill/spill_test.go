@@ -0,0 +1,131 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package spill
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// flakyExporter fails the exports while down and counts the successful ones.
+type flakyExporter struct {
+	mu       sync.Mutex
+	down     bool
+	exported []string
+}
+
+func (e *flakyExporter) Temporality(k sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(k)
+}
+
+func (e *flakyExporter) Aggregation(k sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(k)
+}
+
+func (e *flakyExporter) Export(_ context.Context, rm *metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.down {
+		return errors.New("collector down")
+	}
+	e.exported = append(e.exported, rm.ScopeMetrics[0].Metrics[0].Name)
+	return nil
+}
+
+func (e *flakyExporter) ForceFlush(context.Context) error { return nil }
+
+func (e *flakyExporter) Shutdown(context.Context) error { return nil }
+
+// batchOf returns a batch with a single counter.
+func batchOf(name string) *metricdata.ResourceMetrics {
+	return &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{{
+		Name: name,
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}}}}}
+}
+
+// TestBufferReplay verifies that the failed batches are spilled and replayed, oldest
+// first and one per successful export, after the live batch.
+func TestBufferReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	buffer, err := New(Config{Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inner := &flakyExporter{down: true}
+	exp := buffer.Wrap(inner)
+
+	for _, name := range []string{"spilled-1", "spilled-2"} {
+		if err := exp.Export(ctx, batchOf(name)); err == nil {
+			t.Fatal("got nil error, want the export error")
+		}
+	}
+
+	inner.down = false
+
+	for _, name := range []string{"live-1", "live-2", "live-3"} {
+		if err := exp.Export(ctx, batchOf(name)); err != nil {
+			t.Fatalf("got error %v, want nil for the live batch", err)
+		}
+	}
+
+	want := []string{"live-1", "spilled-1", "live-2", "spilled-2", "live-3"}
+	if !slices.Equal(inner.exported, want) {
+		t.Errorf("got exports %v, want %v", inner.exported, want)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("got %d spilled files after the replay, want 0", len(entries))
+	}
+}
+
+// failingReplayExporter fails the exports of the replayed batches.
+type failingReplayExporter struct {
+	flakyExporter
+}
+
+func (e *failingReplayExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if strings.HasPrefix(rm.ScopeMetrics[0].Metrics[0].Name, "spilled") {
+		return errors.New("replay rejected")
+	}
+	return e.flakyExporter.Export(ctx, rm)
+}
+
+// TestBufferReplayFailure verifies that a batch whose replay fails is kept for the
+// next successful export, without failing the live export.
+func TestBufferReplayFailure(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	buffer, err := New(Config{Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := buffer.spill(batchOf("spilled")); err != nil {
+		t.Fatal(err)
+	}
+
+	inner := &failingReplayExporter{}
+	if err := buffer.Wrap(inner).Export(ctx, batchOf("live")); err != nil {
+		t.Fatalf("got error %v, want nil for the live batch", err)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("got %d spilled files after the failed replay, want 1", len(entries))
+	}
+}
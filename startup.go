@@ -0,0 +1,95 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// clockTicks is the USER_HZ used by /proc/self/stat, fixed to 100 on Linux platforms.
+const clockTicks = 100
+
+var (
+	// packageInit is the time this package was initialized, used as the process start
+	// time when it cannot be read from the operating system.
+	packageInit = time.Now()
+
+	// startupOnce ensures the startup metrics are only recorded by the first Install.
+	startupOnce sync.Once
+)
+
+// recordStartup records, once per process, the app.init.duration gauge, the time
+// from the process start to the Install completion, used for serverless latency
+// analysis. The cold starts are counted by RecordColdStart in faas.coldstarts.
+func recordStartup(meter metric.Meter) {
+	startupOnce.Do(func() {
+		duration, err := meter.Float64Gauge("app.init.duration", metric.WithDescription("Time from the process start to the metrics installation."), metric.WithUnit("s"))
+		if err != nil {
+			otel.Handle(err)
+			return
+		}
+
+		duration.Record(context.Background(), time.Since(processStartTime()).Seconds())
+	})
+}
+
+// processStartTime returns the process start time read from /proc on Linux, or the
+// package initialization time on other platforms.
+func processStartTime() time.Time {
+	stat, err := os.ReadFile("/proc/self/stat")
+	if err != nil {
+		return packageInit
+	}
+
+	// The command name may contain spaces, the fields are counted after its closing parenthesis
+	s := string(stat)
+	fields := strings.Fields(s[strings.LastIndexByte(s, ')')+1:])
+	// starttime is the 22nd field, the 20th after the state
+	if len(fields) < 20 {
+		return packageInit
+	}
+	ticks, err := strconv.ParseInt(fields[19], 10, 64)
+	if err != nil {
+		return packageInit
+	}
+
+	boot, ok := bootTime()
+	if !ok {
+		return packageInit
+	}
+
+	return boot.Add(time.Duration(ticks) * time.Second / clockTicks)
+}
+
+// bootTime reads the system boot time from the btime line of /proc/stat.
+func bootTime() (time.Time, bool) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return time.Time{}, false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if v, ok := strings.CutPrefix(scanner.Text(), "btime "); ok {
+			sec, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return time.Time{}, false
+			}
+			return time.Unix(sec, 0), true
+		}
+	}
+
+	return time.Time{}, false
+}
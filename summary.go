@@ -0,0 +1,135 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/goxkit/metrics/internal/window"
+	"github.com/goxkit/metrics/sanitize"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type (
+	// Summary is a lightweight alternative to a histogram for values whose
+	// distribution isn't worth the bucket series, such as batch sizes. It keeps the
+	// minimum, maximum, sum and count of the values recorded during each collection
+	// interval, exported as the <name>.min, <name>.max, <name>.sum and <name>.count
+	// gauges per attribute set, and resets them on every collection of the exporting
+	// reader, see WindowProducer.
+	//
+	// A Summary is safe for concurrent use.
+	Summary struct {
+		mu     sync.Mutex
+		points map[attribute.Distinct]*summaryPoint
+
+		unregister func()
+	}
+
+	// summaryPoint is the interval summary of an attribute set.
+	summaryPoint struct {
+		set      attribute.Set
+		min, max float64
+		sum      float64
+		count    int64
+	}
+)
+
+// NewSummary creates a Summary exported as gauges prefixed with the given name.
+//
+// Parameters:
+//   - name: The gauges name prefix, such as "jobs.batch.size"
+//   - opts: The gauge options, of which the unit applies to the min, max and sum gauges
+//
+// Returns:
+//   - A new Summary
+//   - An error if the gauges cannot be registered
+func (r *Recorder) NewSummary(name string, opts ...metric.Float64ObservableGaugeOption) (*Summary, error) {
+	s := &Summary{points: map[attribute.Distinct]*summaryPoint{}}
+
+	// The value gauges share the unit of the summary
+	unit := metric.NewFloat64ObservableGaugeConfig(opts...).Unit()
+
+	names := []string{name + ".min", name + ".max", name + ".sum", name + ".count"}
+	unregister, err := window.Default.Register(instrumentationScope, names, func(start, now time.Time) []metricdata.Metrics {
+		points := s.collect()
+		if len(points) == 0 {
+			return nil
+		}
+
+		mins := make([]metricdata.DataPoint[float64], 0, len(points))
+		maxs := make([]metricdata.DataPoint[float64], 0, len(points))
+		sums := make([]metricdata.DataPoint[float64], 0, len(points))
+		counts := make([]metricdata.DataPoint[int64], 0, len(points))
+		for _, point := range points {
+			mins = append(mins, windowPoint(point.set, start, now, point.min))
+			maxs = append(maxs, windowPoint(point.set, start, now, point.max))
+			sums = append(sums, windowPoint(point.set, start, now, point.sum))
+			counts = append(counts, windowPoint(point.set, start, now, point.count))
+		}
+
+		return []metricdata.Metrics{
+			window.Gauge(names[0], "Minimum value recorded during the collection interval.", unit, mins),
+			window.Gauge(names[1], "Maximum value recorded during the collection interval.", unit, maxs),
+			window.Gauge(names[2], "Sum of the values recorded during the collection interval.", unit, sums),
+			window.Gauge(names[3], "Number of values recorded during the collection interval.", "{value}", counts),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.unregister = unregister
+
+	return s, nil
+}
+
+// NewSummary creates a Summary exported as gauges prefixed with the given name using the default Recorder.
+func NewSummary(name string, opts ...metric.Float64ObservableGaugeOption) (*Summary, error) {
+	return defaultRecorder.NewSummary(name, opts...)
+}
+
+// Record adds a value to the interval summary of the attribute set.
+//
+// Parameters:
+//   - value: The value, such as a batch size
+//   - attrs: The attributes of the measurement
+func (s *Summary) Record(value float64, attrs ...attribute.KeyValue) {
+	set := attribute.NewSet(sanitize.Attributes(attrs)...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	point, ok := s.points[set.Equivalent()]
+	if !ok {
+		s.points[set.Equivalent()] = &summaryPoint{set: set, min: value, max: value, sum: value, count: 1}
+		return
+	}
+	point.min = min(point.min, value)
+	point.max = max(point.max, value)
+	point.sum += value
+	point.count++
+}
+
+// Unregister stops exporting the summary.
+//
+// Returns:
+//   - Always nil, the error is kept for the symmetry with the other registrations
+func (s *Summary) Unregister() error {
+	s.unregister()
+	return nil
+}
+
+// collect returns the interval summaries, then resets them for the next interval.
+func (s *Summary) collect() map[attribute.Distinct]*summaryPoint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	points := s.points
+	s.points = make(map[attribute.Distinct]*summaryPoint, len(points))
+	return points
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// TopKRemainderKey is the attribute of the series aggregating the values outside the
+// top-K, set to true. The remainder series has no key attribute, so it can't collide
+// with a counted key.
+const TopKRemainderKey = attribute.Key("topk.remainder")
+
+type (
+	// TopKCounter counts values per key, such as errors per route, while bounding
+	// the cardinality of the exported series. It maintains a Space-Saving heavy
+	// hitters sketch and only exports the top-K keys as labeled series plus a
+	// remainder series, labeled TopKRemainderKey, holding the rest of the total.
+	//
+	// Since keys may enter and leave the top-K, the series are exported as an
+	// observable up-down counter. A TopKCounter is safe for concurrent use.
+	TopKCounter struct {
+		key      attribute.Key
+		k        int
+		capacity int
+
+		mu      sync.Mutex
+		entries map[string]*TopKEntry
+		total   float64
+	}
+
+	// TopKEntry is a key monitored by a TopKCounter with its estimated count.
+	TopKEntry struct {
+		Key   string
+		Count float64
+	}
+)
+
+// NewTopKCounter creates a TopKCounter exported with the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create the instrument
+//   - name: The instrument name
+//   - key: The attribute key holding the counted keys, for instance "route"
+//   - k: The number of keys exported
+//   - opts: The instrument options, such as the description and unit
+//
+// Returns:
+//   - A new TopKCounter
+//   - An error if the instrument or its callback cannot be registered
+func NewTopKCounter(meter metric.Meter, name, key string, k int, opts ...metric.Float64ObservableUpDownCounterOption) (*TopKCounter, error) {
+	if k < 1 {
+		k = 1
+	}
+
+	c := &TopKCounter{
+		key: attribute.Key(key),
+		k:   k,
+		// The sketch monitors more keys than exported to improve the top-K accuracy
+		capacity: k * 10,
+		entries:  map[string]*TopKEntry{},
+	}
+
+	counter, err := meter.Float64ObservableUpDownCounter(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		top, remainder := c.Top()
+		for _, e := range top {
+			observer.ObserveFloat64(counter, e.Count, metric.WithAttributes(c.key.String(e.Key)))
+		}
+		observer.ObserveFloat64(counter, remainder, metric.WithAttributes(TopKRemainderKey.Bool(true)))
+		return nil
+	}, counter); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Add adds value to the count of key. When the sketch is full, the key with the
+// lowest count is replaced and its count is inherited, as in the Space-Saving algorithm.
+//
+// Parameters:
+//   - key: The counted key
+//   - value: The non-negative increment
+func (c *TopKCounter) Add(key string, value float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.total += value
+
+	if e, ok := c.entries[key]; ok {
+		e.Count += value
+		return
+	}
+
+	if len(c.entries) < c.capacity {
+		c.entries[key] = &TopKEntry{Key: key, Count: value}
+		return
+	}
+
+	var lowest *TopKEntry
+	for _, e := range c.entries {
+		if lowest == nil || e.Count < lowest.Count {
+			lowest = e
+		}
+	}
+	delete(c.entries, lowest.Key)
+	c.entries[key] = &TopKEntry{Key: key, Count: lowest.Count + value}
+}
+
+// Top returns the estimated counts of the top-K keys, in decreasing order, and the
+// remainder of the total not attributed to them.
+func (c *TopKCounter) Top() (top []TopKEntry, remainder float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	top = make([]TopKEntry, 0, len(c.entries))
+	for _, e := range c.entries {
+		top = append(top, *e)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Key < top[j].Key
+	})
+	if len(top) > c.k {
+		top = top[:c.k]
+	}
+
+	remainder = c.total
+	for _, e := range top {
+		remainder -= e.Count
+	}
+	if remainder < 0 {
+		// Space-Saving overestimates the counts
+		remainder = 0
+	}
+
+	return top, remainder
+}
+
+// TopK creates a TopKCounter using the global MeterProvider.
+//
+// Parameters:
+//   - name: The instrument name
+//   - key: The attribute key holding the counted keys
+//   - k: The number of keys exported
+//
+// Returns:
+//   - A new TopKCounter
+//   - An error if the instrument or its callback cannot be registered
+func TopK(name, key string, k int) (*TopKCounter, error) {
+	return NewTopKCounter(otel.Meter(instrumentationScope), name, key, k)
+}
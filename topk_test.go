@@ -0,0 +1,49 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TestTopKRemainder verifies that a counted key named like the former remainder
+// value doesn't collide with the remainder series.
+func TestTopKRemainder(t *testing.T) {
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	c, err := NewTopKCounter(provider.Meter("test"), "errors", "route", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add("other", 5)
+	c.Add("/orders", 2)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	dps := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[float64]).DataPoints
+	if len(dps) != 2 {
+		t.Fatalf("got %d series, want the top key and the remainder", len(dps))
+	}
+	for _, dp := range dps {
+		route, hasRoute := dp.Attributes.Value("route")
+		_, isRemainder := dp.Attributes.Value(TopKRemainderKey)
+		switch {
+		case isRemainder && !hasRoute && dp.Value == 2:
+		case !isRemainder && route.AsString() == "other" && dp.Value == 5:
+		default:
+			t.Errorf("unexpected series %v = %v", dp.Attributes.ToSlice(), dp.Value)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+// Package views provides helpers to generate OpenTelemetry SDK views from simple
+// declarations, removing the boilerplate of writing views by hand.
+package views
+
+import (
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// AttributeAllowlist generates one view per instrument keeping only the allowed
+// attribute keys. Any other attribute recorded by the instrument is dropped before
+// aggregation, which protects the backend from unexpected attributes.
+//
+// Instrument names follow the sdkmetric.Instrument criteria, so wildcards such as
+// "http.*" are supported. The views are generated in instrument name order.
+//
+// Parameters:
+//   - allowed: A map of instrument name to the attribute keys allowed for it
+//
+// Returns:
+//   - The views to be registered in the MeterProvider
+func AttributeAllowlist(allowed map[string][]string) []sdkmetric.View {
+	names := make([]string, 0, len(allowed))
+	for name := range allowed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	views := make([]sdkmetric.View, 0, len(names))
+	for _, name := range names {
+		keys := make([]attribute.Key, 0, len(allowed[name]))
+		for _, k := range allowed[name] {
+			keys = append(keys, attribute.Key(k))
+		}
+
+		views = append(views, sdkmetric.NewView(
+			sdkmetric.Instrument{Name: name},
+			sdkmetric.Stream{AttributeFilter: attribute.NewAllowKeysFilter(keys...)},
+		))
+	}
+
+	return views
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type (
+	// Watchdog tracks operations expected to complete within a maximum duration.
+	// When an operation exceeds it, the longtask.exceeded counter is incremented and
+	// the longtask.in_progress_duration gauge reports its running time until it
+	// completes, highlighting stuck operations in real time.
+	//
+	// A Watchdog is safe for concurrent use.
+	Watchdog struct {
+		exceeded   metric.Int64Counter
+		inProgress metric.Float64ObservableGauge
+
+		mu    sync.Mutex
+		tasks map[*longTask]struct{}
+	}
+
+	// longTask is an operation tracked by the Watchdog.
+	longTask struct {
+		name     string
+		start    time.Time
+		timer    *time.Timer
+		exceeded bool
+	}
+)
+
+var (
+	defaultWatchdog     *Watchdog
+	defaultWatchdogErr  error
+	defaultWatchdogOnce sync.Once
+)
+
+// NewWatchdog creates a Watchdog whose instruments are created with the given meter.
+//
+// Parameters:
+//   - meter: The OpenTelemetry meter used to create instruments
+//
+// Returns:
+//   - A new Watchdog
+//   - An error if the instruments cannot be created
+func NewWatchdog(meter metric.Meter) (*Watchdog, error) {
+	exceeded, err := meter.Int64Counter("longtask.exceeded", metric.WithDescription("Number of operations that exceeded their expected maximum duration."))
+	if err != nil {
+		return nil, err
+	}
+
+	inProgress, err := meter.Float64ObservableGauge("longtask.in_progress_duration", metric.WithDescription("Running time of the operations exceeding their expected maximum duration."), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watchdog{
+		exceeded:   exceeded,
+		inProgress: inProgress,
+		tasks:      map[*longTask]struct{}{},
+	}
+
+	if _, err := meter.RegisterCallback(w.observe, inProgress); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Start starts tracking an operation expected to complete within maxDuration.
+// The returned function must be called when the operation completes.
+//
+// Parameters:
+//   - ctx: The context used to record the exceeded counter
+//   - name: The operation name, reported as the operation attribute
+//   - maxDuration: The expected maximum duration of the operation
+//
+// Returns:
+//   - A function to call when the operation completes
+func (w *Watchdog) Start(ctx context.Context, name string, maxDuration time.Duration) (done func()) {
+	t := &longTask{name: name, start: time.Now()}
+
+	w.mu.Lock()
+	w.tasks[t] = struct{}{}
+	w.mu.Unlock()
+
+	t.timer = time.AfterFunc(maxDuration, func() {
+		w.mu.Lock()
+		_, running := w.tasks[t]
+		t.exceeded = running
+		w.mu.Unlock()
+
+		if running {
+			w.exceeded.Add(context.WithoutCancel(ctx), 1, metric.WithAttributes(attribute.String("operation", name)))
+		}
+	})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.timer.Stop()
+
+			w.mu.Lock()
+			delete(w.tasks, t)
+			w.mu.Unlock()
+		})
+	}
+}
+
+// Run runs fn, tracking it as an operation expected to complete within maxDuration.
+//
+// Parameters:
+//   - ctx: The context passed to fn
+//   - name: The operation name, reported as the operation attribute
+//   - maxDuration: The expected maximum duration of the operation
+//   - fn: The operation
+//
+// Returns:
+//   - The error returned by fn
+func (w *Watchdog) Run(ctx context.Context, name string, maxDuration time.Duration, fn func(ctx context.Context) error) error {
+	done := w.Start(ctx, name, maxDuration)
+	defer done()
+
+	return fn(ctx)
+}
+
+// observe reports, per operation name, the longest running time of the exceeded operations.
+func (w *Watchdog) observe(_ context.Context, observer metric.Observer) error {
+	w.mu.Lock()
+	longest := map[string]time.Duration{}
+	for t := range w.tasks {
+		if !t.exceeded {
+			continue
+		}
+		if d := time.Since(t.start); d > longest[t.name] {
+			longest[t.name] = d
+		}
+	}
+	w.mu.Unlock()
+
+	for name, d := range longest {
+		observer.ObserveFloat64(w.inProgress, d.Seconds(), metric.WithAttributes(attribute.String("operation", name)))
+	}
+
+	return nil
+}
+
+// WatchLongTask starts tracking an operation using the default Watchdog, created
+// on first use with the global MeterProvider. Instrument creation errors are
+// reported to the OpenTelemetry error handler and the operation is not tracked.
+//
+// Parameters:
+//   - ctx: The context used to record the exceeded counter
+//   - name: The operation name, reported as the operation attribute
+//   - maxDuration: The expected maximum duration of the operation
+//
+// Returns:
+//   - A function to call when the operation completes
+func WatchLongTask(ctx context.Context, name string, maxDuration time.Duration) (done func()) {
+	defaultWatchdogOnce.Do(func() {
+		defaultWatchdog, defaultWatchdogErr = NewWatchdog(otel.Meter(instrumentationScope))
+	})
+
+	if defaultWatchdogErr != nil {
+		otel.Handle(defaultWatchdogErr)
+		return func() {}
+	}
+
+	return defaultWatchdog.Start(ctx, name, maxDuration)
+}
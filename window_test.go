@@ -0,0 +1,144 @@
+// Copyright (c) 2025, The GoKit Authors
+// MIT License
+// All rights reserved.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// twoReaders returns the exporting reader, with the window producer, and another
+// reader of a new MeterProvider, such as the one of the Prometheus handler.
+func twoReaders(t *testing.T) (exporting, other *sdkmetric.ManualReader, recorder *Recorder) {
+	t.Helper()
+
+	exporting = sdkmetric.NewManualReader(sdkmetric.WithProducer(WindowProducer()))
+	other = sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporting), sdkmetric.WithReader(other))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	return exporting, other, NewRecorder(provider.Meter(instrumentationScope))
+}
+
+// gaugeValues collects reader and returns the values of the named gauges.
+func gaugeValues(t *testing.T, reader sdkmetric.Reader, names ...string) map[string]float64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	values := map[string]float64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for _, name := range names {
+				if m.Name != name {
+					continue
+				}
+				switch data := m.Data.(type) {
+				case metricdata.Gauge[float64]:
+					for _, dp := range data.DataPoints {
+						values[name] += dp.Value
+					}
+				case metricdata.Gauge[int64]:
+					for _, dp := range data.DataPoints {
+						values[name] += float64(dp.Value)
+					}
+				}
+			}
+		}
+	}
+	return values
+}
+
+// TestDistinctTwoReaders verifies that only the exporting reader collects and resets
+// the distinct count window.
+func TestDistinctTwoReaders(t *testing.T) {
+	exporting, other, recorder := twoReaders(t)
+
+	users, err := recorder.DistinctCounter("test.distinct.users")
+	if err != nil {
+		t.Fatalf("DistinctCounter: %v", err)
+	}
+	defer func() { _ = users.Unregister() }()
+
+	for i := range 10 {
+		users.Add(fmt.Sprintf("user-%d", i))
+	}
+
+	if values := gaugeValues(t, other, "test.distinct.users"); len(values) != 0 {
+		t.Fatalf("other reader values = %v, want none", values)
+	}
+	if got := gaugeValues(t, exporting, "test.distinct.users")["test.distinct.users"]; got != 10 {
+		t.Fatalf("exported distinct count = %v, want 10", got)
+	}
+	if values := gaugeValues(t, exporting, "test.distinct.users"); len(values) != 0 {
+		t.Fatalf("values after the reset = %v, want none", values)
+	}
+
+	if _, err := recorder.DistinctCounter("test.distinct.users"); err == nil {
+		t.Fatal("second DistinctCounter with the same name succeeded")
+	}
+}
+
+// TestSummaryTwoReaders verifies that only the exporting reader collects and resets
+// the interval summaries.
+func TestSummaryTwoReaders(t *testing.T) {
+	exporting, other, recorder := twoReaders(t)
+
+	batch, err := recorder.NewSummary("test.summary.batch")
+	if err != nil {
+		t.Fatalf("NewSummary: %v", err)
+	}
+	defer func() { _ = batch.Unregister() }()
+
+	for _, v := range []float64{3, 1, 8} {
+		batch.Record(v)
+	}
+
+	names := []string{"test.summary.batch.min", "test.summary.batch.max", "test.summary.batch.sum", "test.summary.batch.count"}
+	if values := gaugeValues(t, other, names...); len(values) != 0 {
+		t.Fatalf("other reader values = %v, want none", values)
+	}
+
+	values := gaugeValues(t, exporting, names...)
+	want := map[string]float64{names[0]: 1, names[1]: 8, names[2]: 12, names[3]: 3}
+	for name, v := range want {
+		if values[name] != v {
+			t.Errorf("%s = %v, want %v", name, values[name], v)
+		}
+	}
+}
+
+// TestPrecisionHistogramTwoReaders verifies that only the exporting reader collects
+// and resets the extrema, while every reader collects the histogram.
+func TestPrecisionHistogramTwoReaders(t *testing.T) {
+	exporting, other, recorder := twoReaders(t)
+
+	latency, err := NewPrecisionHistogram(recorder.meter, "test.precision.latency")
+	if err != nil {
+		t.Fatalf("NewPrecisionHistogram: %v", err)
+	}
+	defer func() { _ = latency.Unregister() }()
+
+	for _, v := range []float64{0.2, 0.05, 1.5} {
+		latency.Record(context.Background(), v)
+	}
+
+	names := []string{"test.precision.latency.max", "test.precision.latency.min"}
+	if values := gaugeValues(t, other, names...); len(values) != 0 {
+		t.Fatalf("other reader values = %v, want none", values)
+	}
+
+	values := gaugeValues(t, exporting, names...)
+	if values[names[0]] != 1.5 || values[names[1]] != 0.05 {
+		t.Fatalf("exported extrema = %v, want max 1.5 and min 0.05", values)
+	}
+}